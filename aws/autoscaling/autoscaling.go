@@ -1,12 +1,21 @@
 package autoscaling
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/pkg/errors"
 )
 
+const (
+	// describeInstancesBatchSize is the maximum number of instance IDs DescribeAutoScalingInstances accepts at once
+	describeInstancesBatchSize = 50
+	// detachInstancesBatchSize is the maximum number of instance IDs DetachInstances accepts at once
+	detachInstancesBatchSize = 20
+)
+
 // Client represents a wrapper of Auto Scaling API
 type Client struct {
 	api autoscalingiface.AutoScalingAPI
@@ -19,64 +28,173 @@ func New(api autoscalingiface.AutoScalingAPI) *Client {
 	}
 }
 
-// DetachInstance detaches instance from the given ASG
-func (c *Client) DetachInstance(groupName, instanceID string) error {
-	_, err := c.api.DetachInstances(&autoscaling.DetachInstancesInput{
-		AutoScalingGroupName: aws.String(groupName),
-		InstanceIds: []*string{
-			aws.String(instanceID),
-		},
-		ShouldDecrementDesiredCapacity: aws.Bool(true),
-	})
-	if err != nil {
-		return errors.Wrap(err, "failed to detach instance")
+// DetachInstances detaches instances from the given ASG
+func (c *Client) DetachInstances(ctx context.Context, groupName string, instanceIDs []string) error {
+	for i := 0; i < len(instanceIDs); i += detachInstancesBatchSize {
+		end := i + detachInstancesBatchSize
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+
+		_, err := c.api.DetachInstancesWithContext(ctx, &autoscaling.DetachInstancesInput{
+			AutoScalingGroupName:           aws.String(groupName),
+			InstanceIds:                    aws.StringSlice(instanceIDs[i:end]),
+			ShouldDecrementDesiredCapacity: aws.Bool(true),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to detach instances")
+		}
 	}
 
 	return nil
 }
 
 // IncreaseInstances increases the number of instance
-func (c *Client) IncreaseInstances(groupName string, delta int) (int, error) {
-	resp, err := c.api.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
-		AutoScalingGroupNames: []*string{
-			aws.String(groupName),
-		},
+func (c *Client) IncreaseInstances(ctx context.Context, groupName string, delta int) (int, error) {
+	currentDesiredCapacity, err := c.RetrieveDesiredCapacity(ctx, groupName)
+	if err != nil {
+		return -1, errors.Wrap(err, "failed to retrieve current desired capacity")
+	}
+
+	targetDesiredCapacity := currentDesiredCapacity + delta
+
+	if err := c.SetDesiredCapacity(ctx, groupName, targetDesiredCapacity); err != nil {
+		return -1, errors.Wrap(err, "failed to increase desired capacity")
+	}
+
+	return targetDesiredCapacity, nil
+}
+
+// ListInstances lists instance IDs in the given ASG
+func (c *Client) ListInstances(ctx context.Context, groupName string) ([]string, error) {
+	asg, err := c.describeGroup(ctx, groupName)
+	if err != nil {
+		return []string{}, err
+	}
+
+	instances := []string{}
+
+	for _, instance := range asg.Instances {
+		instances = append(instances, aws.StringValue(instance.InstanceId))
+	}
+
+	return instances, nil
+}
+
+// RetrieveDesiredCapacity retrieves current desired capacity of the given ASG
+func (c *Client) RetrieveDesiredCapacity(ctx context.Context, groupName string) (int, error) {
+	asg, err := c.describeGroup(ctx, groupName)
+	if err != nil {
+		return -1, err
+	}
+
+	return int(aws.Int64Value(asg.DesiredCapacity)), nil
+}
+
+// RetrieveInstanceGroups retrieves the names of ASGs the given instances belong to, keyed by instance ID
+// Instances not belonging to any ASG are not included
+func (c *Client) RetrieveInstanceGroups(ctx context.Context, instanceIDs []string) (map[string]string, error) {
+	groups := map[string]string{}
+
+	for i := 0; i < len(instanceIDs); i += describeInstancesBatchSize {
+		end := i + describeInstancesBatchSize
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+
+		resp, err := c.api.DescribeAutoScalingInstancesWithContext(ctx, &autoscaling.DescribeAutoScalingInstancesInput{
+			InstanceIds: aws.StringSlice(instanceIDs[i:end]),
+		})
+		if err != nil {
+			return map[string]string{}, errors.Wrap(err, "failed to describe Auto Scaling instances")
+		}
+
+		for _, instance := range resp.AutoScalingInstances {
+			groups[aws.StringValue(instance.InstanceId)] = aws.StringValue(instance.AutoScalingGroupName)
+		}
+	}
+
+	return groups, nil
+}
+
+// RetrieveLoadBalancers retrieves names of Classic Load Balancers attached to the given ASG
+func (c *Client) RetrieveLoadBalancers(ctx context.Context, groupName string) ([]string, error) {
+	resp, err := c.api.DescribeLoadBalancersWithContext(ctx, &autoscaling.DescribeLoadBalancersInput{
+		AutoScalingGroupName: aws.String(groupName),
 	})
 	if err != nil {
-		return -1, errors.Wrap(err, "failed to get AutoScaling Groups")
+		return []string{}, errors.Wrap(err, "failed to retrieve attached load balancers")
 	}
 
-	if len(resp.AutoScalingGroups) == 0 {
-		return -1, errors.Errorf("Auto Scaling Group %q does not exist", groupName)
+	names := []string{}
+
+	for _, lb := range resp.LoadBalancers {
+		names = append(names, aws.StringValue(lb.LoadBalancerName))
 	}
-	asg := resp.AutoScalingGroups[0]
 
-	currentDesiredCapacity := aws.Int64Value(asg.DesiredCapacity)
-	targetDesiredCapacity := currentDesiredCapacity + int64(delta)
+	return names, nil
+}
 
-	_, err = c.api.SetDesiredCapacity(&autoscaling.SetDesiredCapacityInput{
+// RetrieveTargetGroups retrieves ARNs of all target groups attached to the given ASG
+func (c *Client) RetrieveTargetGroups(ctx context.Context, groupName string) ([]string, error) {
+	resp, err := c.api.DescribeLoadBalancerTargetGroupsWithContext(ctx, &autoscaling.DescribeLoadBalancerTargetGroupsInput{
 		AutoScalingGroupName: aws.String(groupName),
-		DesiredCapacity:      aws.Int64(targetDesiredCapacity),
 	})
 	if err != nil {
-		return -1, errors.Wrap(err, "failed to increase desired capacity")
+		return []string{}, errors.Wrap(err, "failed to retirve attached target groups")
+	}
+
+	arns := []string{}
+
+	for _, tg := range resp.LoadBalancerTargetGroups {
+		arns = append(arns, aws.StringValue(tg.LoadBalancerTargetGroupARN))
 	}
 
-	return int(targetDesiredCapacity), nil
+	return arns, nil
 }
 
-// RetrieveTargetGroup retrieves target group ARN attached to the given ASG
-func (c *Client) RetrieveTargetGroup(groupName string) (string, error) {
-	resp, err := c.api.DescribeLoadBalancerTargetGroups(&autoscaling.DescribeLoadBalancerTargetGroupsInput{
+// SetDesiredCapacity sets desired capacity of the given ASG
+func (c *Client) SetDesiredCapacity(ctx context.Context, groupName string, desiredCapacity int) error {
+	_, err := c.api.SetDesiredCapacityWithContext(ctx, &autoscaling.SetDesiredCapacityInput{
 		AutoScalingGroupName: aws.String(groupName),
+		DesiredCapacity:      aws.Int64(int64(desiredCapacity)),
 	})
 	if err != nil {
-		return "", errors.Wrap(err, "failed to retirve attached target group")
+		return errors.Wrap(err, "failed to set desired capacity")
 	}
 
-	if len(resp.LoadBalancerTargetGroups) == 0 {
-		return "", errors.Errorf("no target group is attached to %q", groupName)
+	return nil
+}
+
+// TerminateInstances terminates the given instances in ASG, decrementing desired capacity by the number of them
+// so that ASG does not launch replacements
+func (c *Client) TerminateInstances(ctx context.Context, instanceIDs []string) error {
+	for _, instanceID := range instanceIDs {
+		_, err := c.api.TerminateInstanceInAutoScalingGroupWithContext(ctx, &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+			InstanceId:                     aws.String(instanceID),
+			ShouldDecrementDesiredCapacity: aws.Bool(true),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to terminate instance %s", instanceID)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) describeGroup(ctx context.Context, groupName string) (*autoscaling.Group, error) {
+	resp, err := c.api.DescribeAutoScalingGroupsWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []*string{
+			aws.String(groupName),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get AutoScaling Groups")
+	}
+
+	if len(resp.AutoScalingGroups) == 0 {
+		return nil, errors.Errorf("Auto Scaling Group %q does not exist", groupName)
 	}
 
-	return aws.StringValue(resp.LoadBalancerTargetGroups[0].LoadBalancerTargetGroupARN), nil
+	return resp.AutoScalingGroups[0], nil
 }
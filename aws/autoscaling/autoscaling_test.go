@@ -1,6 +1,9 @@
 package autoscaling
 
 import (
+	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -9,15 +12,18 @@ import (
 	"github.com/golang/mock/gomock"
 )
 
-func TestDetachInstance(t *testing.T) {
+func TestDetachInstances(t *testing.T) {
+	ctx := context.Background()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	api := mock.NewMockAutoScalingAPI(ctrl)
-	api.EXPECT().DetachInstances(&autoscaling.DetachInstancesInput{
+	api.EXPECT().DetachInstancesWithContext(ctx, &autoscaling.DetachInstancesInput{
 		AutoScalingGroupName: aws.String("elasticsearch"),
 		InstanceIds: []*string{
 			aws.String("i-1234abcd"),
+			aws.String("i-5678efab"),
 		},
 		ShouldDecrementDesiredCapacity: aws.Bool(true),
 	}).Return(&autoscaling.DetachInstancesOutput{}, nil)
@@ -27,19 +33,59 @@ func TestDetachInstance(t *testing.T) {
 	}
 
 	groupName := "elasticsearch"
-	instanceID := "i-1234abcd"
+	instanceIDs := []string{
+		"i-1234abcd",
+		"i-5678efab",
+	}
+
+	if err := client.DetachInstances(ctx, groupName, instanceIDs); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestDetachInstances_batch(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	instanceIDs := []string{}
 
-	if err := client.DetachInstance(groupName, instanceID); err != nil {
+	for i := 0; i < 25; i++ {
+		instanceIDs = append(instanceIDs, fmt.Sprintf("i-%08d", i))
+	}
+
+	api := mock.NewMockAutoScalingAPI(ctrl)
+	gomock.InOrder(
+		api.EXPECT().DetachInstancesWithContext(ctx, &autoscaling.DetachInstancesInput{
+			AutoScalingGroupName:           aws.String("elasticsearch"),
+			InstanceIds:                    aws.StringSlice(instanceIDs[:20]),
+			ShouldDecrementDesiredCapacity: aws.Bool(true),
+		}).Return(&autoscaling.DetachInstancesOutput{}, nil),
+		api.EXPECT().DetachInstancesWithContext(ctx, &autoscaling.DetachInstancesInput{
+			AutoScalingGroupName:           aws.String("elasticsearch"),
+			InstanceIds:                    aws.StringSlice(instanceIDs[20:]),
+			ShouldDecrementDesiredCapacity: aws.Bool(true),
+		}).Return(&autoscaling.DetachInstancesOutput{}, nil),
+	)
+
+	client := &Client{
+		api: api,
+	}
+
+	if err := client.DetachInstances(ctx, "elasticsearch", instanceIDs); err != nil {
 		t.Errorf("error should not be raised: %s", err)
 	}
 }
 
 func TestIncreaseInstances(t *testing.T) {
+	ctx := context.Background()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	api := mock.NewMockAutoScalingAPI(ctrl)
-	api.EXPECT().DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+	api.EXPECT().DescribeAutoScalingGroupsWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
 		AutoScalingGroupNames: []*string{
 			aws.String("elasticsearch"),
 		},
@@ -51,7 +97,7 @@ func TestIncreaseInstances(t *testing.T) {
 			},
 		},
 	}, nil)
-	api.EXPECT().SetDesiredCapacity(&autoscaling.SetDesiredCapacityInput{
+	api.EXPECT().SetDesiredCapacityWithContext(ctx, &autoscaling.SetDesiredCapacityInput{
 		AutoScalingGroupName: aws.String("elasticsearch"),
 		DesiredCapacity:      aws.Int64(5),
 	}).Return(&autoscaling.SetDesiredCapacityOutput{}, nil)
@@ -63,7 +109,7 @@ func TestIncreaseInstances(t *testing.T) {
 	groupName := "elasticsearch"
 	delta := 2
 
-	got, err := client.IncreaseInstances(groupName, delta)
+	got, err := client.IncreaseInstances(ctx, groupName, delta)
 	if err != nil {
 		t.Errorf("error should not be raised: %s", err)
 	}
@@ -75,18 +121,181 @@ func TestIncreaseInstances(t *testing.T) {
 	}
 }
 
-func TestRetrieveTargetGroup(t *testing.T) {
+func TestListInstances(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockAutoScalingAPI(ctrl)
+	api.EXPECT().DescribeAutoScalingGroupsWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []*string{
+			aws.String("elasticsearch"),
+		},
+	}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("elasticsearch"),
+				DesiredCapacity:      aws.Int64(2),
+				Instances: []*autoscaling.Instance{
+					&autoscaling.Instance{
+						InstanceId: aws.String("i-1234abcd"),
+					},
+					&autoscaling.Instance{
+						InstanceId: aws.String("i-5678efab"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	groupName := "elasticsearch"
+	expected := []string{
+		"i-1234abcd",
+		"i-5678efab",
+	}
+
+	got, err := client.ListInstances(ctx, groupName)
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("instance IDs does not match. expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestRetrieveDesiredCapacity(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockAutoScalingAPI(ctrl)
+	api.EXPECT().DescribeAutoScalingGroupsWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []*string{
+			aws.String("elasticsearch"),
+		},
+	}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("elasticsearch"),
+				DesiredCapacity:      aws.Int64(3),
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	groupName := "elasticsearch"
+	expected := 3
+
+	got, err := client.RetrieveDesiredCapacity(ctx, groupName)
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("desired capacity does not match. expected: %d, got: %d", expected, got)
+	}
+}
+
+func TestRetrieveInstanceGroups(t *testing.T) {
+	ctx := context.Background()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	api := mock.NewMockAutoScalingAPI(ctrl)
-	api.EXPECT().DescribeLoadBalancerTargetGroups(&autoscaling.DescribeLoadBalancerTargetGroupsInput{
+	api.EXPECT().DescribeAutoScalingInstancesWithContext(ctx, &autoscaling.DescribeAutoScalingInstancesInput{
+		InstanceIds: []*string{
+			aws.String("i-1234abcd"),
+			aws.String("i-5678efab"),
+		},
+	}).Return(&autoscaling.DescribeAutoScalingInstancesOutput{
+		AutoScalingInstances: []*autoscaling.InstanceDetails{
+			&autoscaling.InstanceDetails{
+				AutoScalingGroupName: aws.String("elasticsearch"),
+				InstanceId:           aws.String("i-1234abcd"),
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	expected := map[string]string{
+		"i-1234abcd": "elasticsearch",
+	}
+
+	got, err := client.RetrieveInstanceGroups(ctx, []string{"i-1234abcd", "i-5678efab"})
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("groups does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestRetrieveLoadBalancers(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockAutoScalingAPI(ctrl)
+	api.EXPECT().DescribeLoadBalancersWithContext(ctx, &autoscaling.DescribeLoadBalancersInput{
+		AutoScalingGroupName: aws.String("elasticsearch"),
+	}).Return(&autoscaling.DescribeLoadBalancersOutput{
+		LoadBalancers: []*autoscaling.LoadBalancerState{
+			&autoscaling.LoadBalancerState{
+				LoadBalancerName: aws.String("elasticsearch"),
+				State:            aws.String("InService"),
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	expected := []string{"elasticsearch"}
+
+	got, err := client.RetrieveLoadBalancers(ctx, "elasticsearch")
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("load balancer names does not match. expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestRetrieveTargetGroups(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockAutoScalingAPI(ctrl)
+	api.EXPECT().DescribeLoadBalancerTargetGroupsWithContext(ctx, &autoscaling.DescribeLoadBalancerTargetGroupsInput{
 		AutoScalingGroupName: aws.String("elasticsearch"),
 	}).Return(&autoscaling.DescribeLoadBalancerTargetGroupsOutput{
 		LoadBalancerTargetGroups: []*autoscaling.LoadBalancerTargetGroupState{
 			&autoscaling.LoadBalancerTargetGroupState{
 				LoadBalancerTargetGroupARN: aws.String("arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"),
 			},
+			&autoscaling.LoadBalancerTargetGroupState{
+				LoadBalancerTargetGroupARN: aws.String("arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch-internal/9876fedc5432badc"),
+			},
 		},
 	}, nil)
 
@@ -94,15 +303,66 @@ func TestRetrieveTargetGroup(t *testing.T) {
 		api: api,
 	}
 
-	groupName := "elasticsearch"
-	expected := "arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"
+	expected := []string{
+		"arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab",
+		"arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch-internal/9876fedc5432badc",
+	}
 
-	got, err := client.RetrieveTargetGroup(groupName)
+	got, err := client.RetrieveTargetGroups(ctx, "elasticsearch")
 	if err != nil {
 		t.Errorf("error should not be raised: %s", err)
 	}
 
-	if got != expected {
-		t.Errorf("target group ARN does not match. expected: %q, got: %q", expected, got)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("target group ARNs does not match. expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestSetDesiredCapacity(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockAutoScalingAPI(ctrl)
+	api.EXPECT().SetDesiredCapacityWithContext(ctx, &autoscaling.SetDesiredCapacityInput{
+		AutoScalingGroupName: aws.String("elasticsearch"),
+		DesiredCapacity:      aws.Int64(5),
+	}).Return(&autoscaling.SetDesiredCapacityOutput{}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	groupName := "elasticsearch"
+	desiredCapacity := 5
+
+	if err := client.SetDesiredCapacity(ctx, groupName, desiredCapacity); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestTerminateInstances(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockAutoScalingAPI(ctrl)
+	api.EXPECT().TerminateInstanceInAutoScalingGroupWithContext(ctx, &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String("i-1234abcd"),
+		ShouldDecrementDesiredCapacity: aws.Bool(true),
+	}).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+	api.EXPECT().TerminateInstanceInAutoScalingGroupWithContext(ctx, &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String("i-5678efab"),
+		ShouldDecrementDesiredCapacity: aws.Bool(true),
+	}).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	if err := client.TerminateInstances(ctx, []string{"i-1234abcd", "i-5678efab"}); err != nil {
+		t.Errorf("error should not be raised: %s", err)
 	}
 }
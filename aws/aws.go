@@ -5,9 +5,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	autoscalingapi "github.com/aws/aws-sdk-go/service/autoscaling"
 	ec2api "github.com/aws/aws-sdk-go/service/ec2"
+	elbapi "github.com/aws/aws-sdk-go/service/elb"
 	elbv2api "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/dtan4/esnctl/aws/autoscaling"
 	"github.com/dtan4/esnctl/aws/ec2"
+	"github.com/dtan4/esnctl/aws/elb"
 	"github.com/dtan4/esnctl/aws/elbv2"
 	"github.com/pkg/errors"
 )
@@ -17,6 +19,8 @@ var (
 	AutoScaling *autoscaling.Client
 	// EC2 represents EC2 service client
 	EC2 *ec2.Client
+	// ELB represents Classic Load Balancer service client
+	ELB *elb.Client
 	// ELBv2 represents ELBV2 service client
 	ELBv2 *elbv2.Client
 )
@@ -42,6 +46,7 @@ func Initialize(region string) error {
 
 	AutoScaling = autoscaling.New(autoscalingapi.New(sess))
 	EC2 = ec2.New(ec2api.New(sess))
+	ELB = elb.New(elbapi.New(sess))
 	ELBv2 = elbv2.New(elbv2api.New(sess))
 
 	return nil
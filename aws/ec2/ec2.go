@@ -1,6 +1,10 @@
 package ec2
 
 import (
+	"context"
+	"strings"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -12,6 +16,15 @@ type Client struct {
 	api ec2iface.EC2API
 }
 
+// Instance represents EC2 instance
+type Instance struct {
+	ID               string
+	PrivateIP        string
+	AvailabilityZone string
+	InstanceType     string
+	LaunchTime       time.Time
+}
+
 // New creates and returns new Client object
 func New(api ec2iface.EC2API) *Client {
 	return &Client{
@@ -20,8 +33,8 @@ func New(api ec2iface.EC2API) *Client {
 }
 
 // RetrieveInstanceIDFromPrivateDNS retrieves instance ID from private DNS name
-func (c *Client) RetrieveInstanceIDFromPrivateDNS(privateDNS string) (string, error) {
-	resp, err := c.api.DescribeInstances(&ec2.DescribeInstancesInput{
+func (c *Client) RetrieveInstanceIDFromPrivateDNS(ctx context.Context, privateDNS string) (string, error) {
+	resp, err := c.api.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
 		Filters: []*ec2.Filter{
 			&ec2.Filter{
 				Name: aws.String("private-dns-name"),
@@ -41,3 +54,106 @@ func (c *Client) RetrieveInstanceIDFromPrivateDNS(privateDNS string) (string, er
 
 	return aws.StringValue(resp.Reservations[0].Instances[0].InstanceId), nil
 }
+
+// RetrieveInstanceIDFromPrivateIP retrieves ID of the running instance with the given private IP address
+// Private IP addresses are unique only within a VPC, so an address used in multiple VPCs is rejected
+func (c *Client) RetrieveInstanceIDFromPrivateIP(ctx context.Context, privateIP string) (string, error) {
+	resp, err := c.api.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name: aws.String("private-ip-address"),
+				Values: []*string{
+					aws.String(privateIP),
+				},
+			},
+			&ec2.Filter{
+				Name: aws.String("instance-state-name"),
+				Values: []*string{
+					aws.String(ec2.InstanceStateNameRunning),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to retrieve instance ID")
+	}
+
+	instanceIDs := []string{}
+
+	for _, reservation := range resp.Reservations {
+		for _, instance := range reservation.Instances {
+			instanceIDs = append(instanceIDs, aws.StringValue(instance.InstanceId))
+		}
+	}
+
+	switch len(instanceIDs) {
+	case 0:
+		return "", errors.Errorf("running instance with %q not found", privateIP)
+	case 1:
+		return instanceIDs[0], nil
+	}
+
+	return "", errors.Errorf("multiple running instances with %q found in different VPCs: %s", privateIP, strings.Join(instanceIDs, ", "))
+}
+
+// RetrievePrivateIPFromInstanceID retrieves private IP address of the given instance
+func (c *Client) RetrievePrivateIPFromInstanceID(ctx context.Context, instanceID string) (string, error) {
+	resp, err := c.api.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []*string{
+			aws.String(instanceID),
+		},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to retrieve private IP address")
+	}
+
+	if len(resp.Reservations) == 0 || len(resp.Reservations[0].Instances) == 0 {
+		return "", errors.Errorf("instance %q not found", instanceID)
+	}
+
+	return aws.StringValue(resp.Reservations[0].Instances[0].PrivateIpAddress), nil
+}
+
+// RebootInstances reboots the given instances
+func (c *Client) RebootInstances(ctx context.Context, instanceIDs []string) error {
+	_, err := c.api.RebootInstancesWithContext(ctx, &ec2.RebootInstancesInput{
+		InstanceIds: aws.StringSlice(instanceIDs),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to reboot instances")
+	}
+
+	return nil
+}
+
+// DescribeInstances returns the given instances
+func (c *Client) DescribeInstances(ctx context.Context, instanceIDs []string) ([]*Instance, error) {
+	resp, err := c.api.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: aws.StringSlice(instanceIDs),
+	})
+	if err != nil {
+		return []*Instance{}, errors.Wrap(err, "failed to describe instances")
+	}
+
+	instances := []*Instance{}
+
+	for _, reservation := range resp.Reservations {
+		for _, instance := range reservation.Instances {
+			var availabilityZone string
+
+			if instance.Placement != nil {
+				availabilityZone = aws.StringValue(instance.Placement.AvailabilityZone)
+			}
+
+			instances = append(instances, &Instance{
+				ID:               aws.StringValue(instance.InstanceId),
+				PrivateIP:        aws.StringValue(instance.PrivateIpAddress),
+				AvailabilityZone: availabilityZone,
+				InstanceType:     aws.StringValue(instance.InstanceType),
+				LaunchTime:       aws.TimeValue(instance.LaunchTime),
+			})
+		}
+	}
+
+	return instances, nil
+}
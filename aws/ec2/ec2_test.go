@@ -1,7 +1,10 @@
 package ec2
 
 import (
+	"context"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -10,11 +13,13 @@ import (
 )
 
 func TestRetrieveInstanceIDFromPrivateDNS(t *testing.T) {
+	ctx := context.Background()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	api := mock.NewMockEC2API(ctrl)
-	api.EXPECT().DescribeInstances(&ec2.DescribeInstancesInput{
+	api.EXPECT().DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
 		Filters: []*ec2.Filter{
 			&ec2.Filter{
 				Name: aws.String("private-dns-name"),
@@ -43,7 +48,7 @@ func TestRetrieveInstanceIDFromPrivateDNS(t *testing.T) {
 	privateDNS := "ip-10-0-1-23.ap-northeast-1.compute.internal"
 	expected := "i-1234abcd"
 
-	got, err := client.RetrieveInstanceIDFromPrivateDNS(privateDNS)
+	got, err := client.RetrieveInstanceIDFromPrivateDNS(ctx, privateDNS)
 	if err != nil {
 		t.Errorf("error should not be raised: %s", err)
 	}
@@ -52,3 +57,225 @@ func TestRetrieveInstanceIDFromPrivateDNS(t *testing.T) {
 		t.Errorf("instance ID does not match. expected: %q, got: %q", expected, got)
 	}
 }
+
+func TestRetrieveInstanceIDFromPrivateIP(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockEC2API(ctrl)
+	api.EXPECT().DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name: aws.String("private-ip-address"),
+				Values: []*string{
+					aws.String("10.0.1.23"),
+				},
+			},
+			&ec2.Filter{
+				Name: aws.String("instance-state-name"),
+				Values: []*string{
+					aws.String("running"),
+				},
+			},
+		},
+	}).Return(&ec2.DescribeInstancesOutput{
+		Reservations: []*ec2.Reservation{
+			&ec2.Reservation{
+				Instances: []*ec2.Instance{
+					&ec2.Instance{
+						InstanceId:       aws.String("i-1234abcd"),
+						PrivateIpAddress: aws.String("10.0.1.23"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	privateIP := "10.0.1.23"
+	expected := "i-1234abcd"
+
+	got, err := client.RetrieveInstanceIDFromPrivateIP(ctx, privateIP)
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("instance ID does not match. expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestRetrieveInstanceIDFromPrivateIP_multipleVPCs(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockEC2API(ctrl)
+	api.EXPECT().DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name: aws.String("private-ip-address"),
+				Values: []*string{
+					aws.String("10.0.1.23"),
+				},
+			},
+			&ec2.Filter{
+				Name: aws.String("instance-state-name"),
+				Values: []*string{
+					aws.String("running"),
+				},
+			},
+		},
+	}).Return(&ec2.DescribeInstancesOutput{
+		Reservations: []*ec2.Reservation{
+			&ec2.Reservation{
+				Instances: []*ec2.Instance{
+					&ec2.Instance{
+						InstanceId:       aws.String("i-1234abcd"),
+						PrivateIpAddress: aws.String("10.0.1.23"),
+					},
+				},
+			},
+			&ec2.Reservation{
+				Instances: []*ec2.Instance{
+					&ec2.Instance{
+						InstanceId:       aws.String("i-5678efgh"),
+						PrivateIpAddress: aws.String("10.0.1.23"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	if _, err := client.RetrieveInstanceIDFromPrivateIP(ctx, "10.0.1.23"); err == nil {
+		t.Errorf("error should be raised")
+	}
+}
+
+func TestRetrievePrivateIPFromInstanceID(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockEC2API(ctrl)
+	api.EXPECT().DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []*string{
+			aws.String("i-1234abcd"),
+		},
+	}).Return(&ec2.DescribeInstancesOutput{
+		Reservations: []*ec2.Reservation{
+			&ec2.Reservation{
+				Instances: []*ec2.Instance{
+					&ec2.Instance{
+						InstanceId:       aws.String("i-1234abcd"),
+						PrivateIpAddress: aws.String("10.0.1.23"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	instanceID := "i-1234abcd"
+	expected := "10.0.1.23"
+
+	got, err := client.RetrievePrivateIPFromInstanceID(ctx, instanceID)
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("private IP address does not match. expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestRebootInstances(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockEC2API(ctrl)
+	api.EXPECT().RebootInstancesWithContext(ctx, &ec2.RebootInstancesInput{
+		InstanceIds: []*string{
+			aws.String("i-1234abcd"),
+		},
+	}).Return(&ec2.RebootInstancesOutput{}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	if err := client.RebootInstances(ctx, []string{"i-1234abcd"}); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestDescribeInstances(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	launchTime := time.Date(2017, 3, 20, 12, 34, 56, 0, time.UTC)
+
+	api := mock.NewMockEC2API(ctrl)
+	api.EXPECT().DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []*string{
+			aws.String("i-1234abcd"),
+		},
+	}).Return(&ec2.DescribeInstancesOutput{
+		Reservations: []*ec2.Reservation{
+			&ec2.Reservation{
+				Instances: []*ec2.Instance{
+					&ec2.Instance{
+						InstanceId:       aws.String("i-1234abcd"),
+						PrivateIpAddress: aws.String("10.0.1.23"),
+						InstanceType:     aws.String("r5.xlarge"),
+						LaunchTime:       aws.Time(launchTime),
+						Placement: &ec2.Placement{
+							AvailabilityZone: aws.String("ap-northeast-1a"),
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	expected := []*Instance{
+		&Instance{
+			ID:               "i-1234abcd",
+			PrivateIP:        "10.0.1.23",
+			AvailabilityZone: "ap-northeast-1a",
+			InstanceType:     "r5.xlarge",
+			LaunchTime:       launchTime,
+		},
+	}
+
+	got, err := client.DescribeInstances(ctx, []string{"i-1234abcd"})
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("instances does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
@@ -0,0 +1,121 @@
+package elb
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elb/elbiface"
+	"github.com/pkg/errors"
+)
+
+// Client represents a wrapper of Classic Load Balancer API
+type Client struct {
+	api elbiface.ELBAPI
+}
+
+// New creates and returns new Client object
+func New(api elbiface.ELBAPI) *Client {
+	return &Client{
+		api: api,
+	}
+}
+
+// DetachInstances deregisters the given instances from the given load balancer
+func (c *Client) DetachInstances(ctx context.Context, loadBalancerName string, instanceIDs []string) error {
+	_, err := c.api.DeregisterInstancesFromLoadBalancerWithContext(ctx, &elb.DeregisterInstancesFromLoadBalancerInput{
+		LoadBalancerName: aws.String(loadBalancerName),
+		Instances:        instances(instanceIDs),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to detach instances")
+	}
+
+	return nil
+}
+
+// ListInstanceHealth lists health state of instances registered to the given load balancer, keyed by instance ID
+// State is one of "InService", "OutOfService" or "Unknown"
+func (c *Client) ListInstanceHealth(ctx context.Context, loadBalancerName string) (map[string]string, error) {
+	resp, err := c.api.DescribeInstanceHealthWithContext(ctx, &elb.DescribeInstanceHealthInput{
+		LoadBalancerName: aws.String(loadBalancerName),
+	})
+	if err != nil {
+		return map[string]string{}, errors.Wrap(err, "failed to describe instance health")
+	}
+
+	health := map[string]string{}
+
+	for _, state := range resp.InstanceStates {
+		health[aws.StringValue(state.InstanceId)] = aws.StringValue(state.State)
+	}
+
+	return health, nil
+}
+
+// ListInstances lists instance IDs registered to the given load balancer
+func (c *Client) ListInstances(ctx context.Context, loadBalancerName string) ([]string, error) {
+	resp, err := c.api.DescribeInstanceHealthWithContext(ctx, &elb.DescribeInstanceHealthInput{
+		LoadBalancerName: aws.String(loadBalancerName),
+	})
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to list registered instances")
+	}
+
+	instanceIDs := []string{}
+
+	for _, state := range resp.InstanceStates {
+		instanceIDs = append(instanceIDs, aws.StringValue(state.InstanceId))
+	}
+
+	return instanceIDs, nil
+}
+
+// RegisterInstances registers the given instances to the given load balancer
+func (c *Client) RegisterInstances(ctx context.Context, loadBalancerName string, instanceIDs []string) error {
+	_, err := c.api.RegisterInstancesWithLoadBalancerWithContext(ctx, &elb.RegisterInstancesWithLoadBalancerInput{
+		LoadBalancerName: aws.String(loadBalancerName),
+		Instances:        instances(instanceIDs),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to register instances")
+	}
+
+	return nil
+}
+
+// RetrieveConnectionDrainingTimeout retrieves how long the given load balancer keeps connections to deregistered instances
+// Zero is returned if connection draining is disabled
+func (c *Client) RetrieveConnectionDrainingTimeout(ctx context.Context, loadBalancerName string) (time.Duration, error) {
+	resp, err := c.api.DescribeLoadBalancerAttributesWithContext(ctx, &elb.DescribeLoadBalancerAttributesInput{
+		LoadBalancerName: aws.String(loadBalancerName),
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to describe load balancer attributes")
+	}
+
+	if resp.LoadBalancerAttributes == nil || resp.LoadBalancerAttributes.ConnectionDraining == nil {
+		return 0, nil
+	}
+
+	draining := resp.LoadBalancerAttributes.ConnectionDraining
+
+	if !aws.BoolValue(draining.Enabled) {
+		return 0, nil
+	}
+
+	return time.Duration(aws.Int64Value(draining.Timeout)) * time.Second, nil
+}
+
+func instances(instanceIDs []string) []*elb.Instance {
+	instances := []*elb.Instance{}
+
+	for _, instanceID := range instanceIDs {
+		instances = append(instances, &elb.Instance{
+			InstanceId: aws.String(instanceID),
+		})
+	}
+
+	return instances
+}
@@ -0,0 +1,206 @@
+package elb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/dtan4/esnctl/aws/mock"
+	"github.com/golang/mock/gomock"
+)
+
+func TestDetachInstances(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockELBAPI(ctrl)
+	api.EXPECT().DeregisterInstancesFromLoadBalancerWithContext(ctx, &elb.DeregisterInstancesFromLoadBalancerInput{
+		LoadBalancerName: aws.String("elasticsearch"),
+		Instances: []*elb.Instance{
+			&elb.Instance{
+				InstanceId: aws.String("i-1234abcd"),
+			},
+			&elb.Instance{
+				InstanceId: aws.String("i-5678efab"),
+			},
+		},
+	}).Return(&elb.DeregisterInstancesFromLoadBalancerOutput{}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	loadBalancerName := "elasticsearch"
+	instanceIDs := []string{
+		"i-1234abcd",
+		"i-5678efab",
+	}
+
+	if err := client.DetachInstances(ctx, loadBalancerName, instanceIDs); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestListInstanceHealth(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockELBAPI(ctrl)
+	api.EXPECT().DescribeInstanceHealthWithContext(ctx, &elb.DescribeInstanceHealthInput{
+		LoadBalancerName: aws.String("elasticsearch"),
+	}).Return(&elb.DescribeInstanceHealthOutput{
+		InstanceStates: []*elb.InstanceState{
+			&elb.InstanceState{
+				InstanceId: aws.String("i-1234abcd"),
+				State:      aws.String("InService"),
+			},
+			&elb.InstanceState{
+				InstanceId: aws.String("i-5678efab"),
+				State:      aws.String("OutOfService"),
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	expected := map[string]string{
+		"i-1234abcd": "InService",
+		"i-5678efab": "OutOfService",
+	}
+
+	got, err := client.ListInstanceHealth(ctx, "elasticsearch")
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("instance health does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestListInstances(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockELBAPI(ctrl)
+	api.EXPECT().DescribeInstanceHealthWithContext(ctx, &elb.DescribeInstanceHealthInput{
+		LoadBalancerName: aws.String("elasticsearch"),
+	}).Return(&elb.DescribeInstanceHealthOutput{
+		InstanceStates: []*elb.InstanceState{
+			&elb.InstanceState{
+				InstanceId: aws.String("i-1234abcd"),
+				State:      aws.String("InService"),
+			},
+			&elb.InstanceState{
+				InstanceId: aws.String("i-5678efab"),
+				State:      aws.String("InService"),
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	expected := []string{
+		"i-1234abcd",
+		"i-5678efab",
+	}
+
+	got, err := client.ListInstances(ctx, "elasticsearch")
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("instance IDs does not match. expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestRegisterInstances(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockELBAPI(ctrl)
+	api.EXPECT().RegisterInstancesWithLoadBalancerWithContext(ctx, &elb.RegisterInstancesWithLoadBalancerInput{
+		LoadBalancerName: aws.String("elasticsearch"),
+		Instances: []*elb.Instance{
+			&elb.Instance{
+				InstanceId: aws.String("i-1234abcd"),
+			},
+		},
+	}).Return(&elb.RegisterInstancesWithLoadBalancerOutput{}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	if err := client.RegisterInstances(ctx, "elasticsearch", []string{"i-1234abcd"}); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestRetrieveConnectionDrainingTimeout(t *testing.T) {
+	testcases := []struct {
+		draining *elb.ConnectionDraining
+		expected time.Duration
+	}{
+		{
+			draining: &elb.ConnectionDraining{
+				Enabled: aws.Bool(true),
+				Timeout: aws.Int64(300),
+			},
+			expected: 5 * time.Minute,
+		},
+		{
+			draining: &elb.ConnectionDraining{
+				Enabled: aws.Bool(false),
+				Timeout: aws.Int64(300),
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testcases {
+		ctx := context.Background()
+
+		ctrl := gomock.NewController(t)
+
+		api := mock.NewMockELBAPI(ctrl)
+		api.EXPECT().DescribeLoadBalancerAttributesWithContext(ctx, &elb.DescribeLoadBalancerAttributesInput{
+			LoadBalancerName: aws.String("elasticsearch"),
+		}).Return(&elb.DescribeLoadBalancerAttributesOutput{
+			LoadBalancerAttributes: &elb.LoadBalancerAttributes{
+				ConnectionDraining: tc.draining,
+			},
+		}, nil)
+
+		client := &Client{
+			api: api,
+		}
+
+		got, err := client.RetrieveConnectionDrainingTimeout(ctx, "elasticsearch")
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+		}
+
+		if got != tc.expected {
+			t.Errorf("timeout does not match. expected: %s, got: %s", tc.expected, got)
+		}
+
+		ctrl.Finish()
+	}
+}
@@ -1,6 +1,8 @@
 package elbv2
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
@@ -19,26 +21,53 @@ func New(api elbv2iface.ELBV2API) *Client {
 	}
 }
 
-// DetachInstance detaches the given instance from the given target group
-func (c *Client) DetachInstance(targetGroupARN, instanceID string) error {
-	_, err := c.api.DeregisterTargets(&elbv2.DeregisterTargetsInput{
+// DetachInstances detaches the given instances from the given target group
+func (c *Client) DetachInstances(ctx context.Context, targetGroupARN string, instanceIDs []string) error {
+	targets := []*elbv2.TargetDescription{}
+
+	for _, instanceID := range instanceIDs {
+		targets = append(targets, &elbv2.TargetDescription{
+			Id: aws.String(instanceID),
+		})
+	}
+
+	_, err := c.api.DeregisterTargetsWithContext(ctx, &elbv2.DeregisterTargetsInput{
 		TargetGroupArn: aws.String(targetGroupARN),
-		Targets: []*elbv2.TargetDescription{
-			&elbv2.TargetDescription{
-				Id: aws.String(instanceID),
-			},
-		},
+		Targets:        targets,
 	})
 	if err != nil {
-		return errors.Wrap(err, "failed to detach instance")
+		return errors.Wrap(err, "failed to detach instances")
 	}
 
 	return nil
 }
 
+// ListTargetHealth lists health state of instances attached to the given target group, keyed by instance ID
+// State is one of "initial", "healthy", "unhealthy", "unused", "draining" or "unavailable"
+func (c *Client) ListTargetHealth(ctx context.Context, targetGroupARN string) (map[string]string, error) {
+	resp, err := c.api.DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	})
+	if err != nil {
+		return map[string]string{}, errors.Wrap(err, "failed to describe target health")
+	}
+
+	health := map[string]string{}
+
+	for _, description := range resp.TargetHealthDescriptions {
+		if description.Target == nil || description.TargetHealth == nil {
+			continue
+		}
+
+		health[aws.StringValue(description.Target.Id)] = aws.StringValue(description.TargetHealth.State)
+	}
+
+	return health, nil
+}
+
 // ListTargetInstances lists instance IDs attached to the given target group
-func (c *Client) ListTargetInstances(targetGroupARN string) ([]string, error) {
-	resp, err := c.api.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+func (c *Client) ListTargetInstances(ctx context.Context, targetGroupARN string) ([]string, error) {
+	resp, err := c.api.DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{
 		TargetGroupArn: aws.String(targetGroupARN),
 	})
 	if err != nil {
@@ -47,9 +76,34 @@ func (c *Client) ListTargetInstances(targetGroupARN string) ([]string, error) {
 
 	instances := []string{}
 
-	for _, health := range resp.TargetHealthDescriptions {
-		instances = append(instances, aws.StringValue(health.Target.Id))
+	for _, description := range resp.TargetHealthDescriptions {
+		if description.Target == nil {
+			continue
+		}
+
+		instances = append(instances, aws.StringValue(description.Target.Id))
 	}
 
 	return instances, nil
 }
+
+// RegisterInstances registers the given instances to the given target group
+func (c *Client) RegisterInstances(ctx context.Context, targetGroupARN string, instanceIDs []string) error {
+	targets := []*elbv2.TargetDescription{}
+
+	for _, instanceID := range instanceIDs {
+		targets = append(targets, &elbv2.TargetDescription{
+			Id: aws.String(instanceID),
+		})
+	}
+
+	_, err := c.api.RegisterTargetsWithContext(ctx, &elbv2.RegisterTargetsInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+		Targets:        targets,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to register instances")
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package elbv2
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -10,17 +11,22 @@ import (
 	"github.com/golang/mock/gomock"
 )
 
-func TestDetachInstance(t *testing.T) {
+func TestDetachInstances(t *testing.T) {
+	ctx := context.Background()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	api := mock.NewMockELBV2API(ctrl)
-	api.EXPECT().DeregisterTargets(&elbv2.DeregisterTargetsInput{
+	api.EXPECT().DeregisterTargetsWithContext(ctx, &elbv2.DeregisterTargetsInput{
 		TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"),
 		Targets: []*elbv2.TargetDescription{
 			&elbv2.TargetDescription{
 				Id: aws.String("i-1234abcd"),
 			},
+			&elbv2.TargetDescription{
+				Id: aws.String("i-5678efab"),
+			},
 		},
 	}).Return(&elbv2.DeregisterTargetsOutput{}, nil)
 
@@ -29,19 +35,74 @@ func TestDetachInstance(t *testing.T) {
 	}
 
 	targetGroupARN := "arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"
-	instanceID := "i-1234abcd"
+	instanceIDs := []string{
+		"i-1234abcd",
+		"i-5678efab",
+	}
+
+	if err := client.DetachInstances(ctx, targetGroupARN, instanceIDs); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestListTargetHealth(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockELBV2API(ctrl)
+	api.EXPECT().DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"),
+	}).Return(&elbv2.DescribeTargetHealthOutput{
+		TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
+			&elbv2.TargetHealthDescription{
+				Target: &elbv2.TargetDescription{
+					Id: aws.String("i-1234abcd"),
+				},
+				TargetHealth: &elbv2.TargetHealth{
+					State: aws.String("healthy"),
+				},
+			},
+			&elbv2.TargetHealthDescription{
+				Target: &elbv2.TargetDescription{
+					Id: aws.String("i-5678efab"),
+				},
+				TargetHealth: &elbv2.TargetHealth{
+					State: aws.String("draining"),
+				},
+			},
+		},
+	}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	targetGroupARN := "arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"
+	expected := map[string]string{
+		"i-1234abcd": "healthy",
+		"i-5678efab": "draining",
+	}
 
-	if err := client.DetachInstance(targetGroupARN, instanceID); err != nil {
+	got, err := client.ListTargetHealth(ctx, targetGroupARN)
+	if err != nil {
 		t.Errorf("error should not be raised: %s", err)
 	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("target health does not match. expected: %#v, got: %#v", expected, got)
+	}
 }
 
 func TestListTargetInstances(t *testing.T) {
+	ctx := context.Background()
+
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	api := mock.NewMockELBV2API(ctrl)
-	api.EXPECT().DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+	api.EXPECT().DescribeTargetHealthWithContext(ctx, &elbv2.DescribeTargetHealthInput{
 		TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"),
 	}).Return(&elbv2.DescribeTargetHealthOutput{
 		TargetHealthDescriptions: []*elbv2.TargetHealthDescription{
@@ -61,6 +122,11 @@ func TestListTargetInstances(t *testing.T) {
 					State: aws.String("draining"),
 				},
 			},
+			&elbv2.TargetHealthDescription{
+				TargetHealth: &elbv2.TargetHealth{
+					State: aws.String("unavailable"),
+				},
+			},
 		},
 	}, nil)
 
@@ -74,7 +140,7 @@ func TestListTargetInstances(t *testing.T) {
 		"i-5678efab",
 	}
 
-	got, err := client.ListTargetInstances(targetGroupARN)
+	got, err := client.ListTargetInstances(ctx, targetGroupARN)
 	if err != nil {
 		t.Errorf("error should not be raised: %s", err)
 	}
@@ -83,3 +149,37 @@ func TestListTargetInstances(t *testing.T) {
 		t.Errorf("instance IDs does not match. expected: %q, got: %q", expected, got)
 	}
 }
+
+func TestRegisterInstances(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockELBV2API(ctrl)
+	api.EXPECT().RegisterTargetsWithContext(ctx, &elbv2.RegisterTargetsInput{
+		TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"),
+		Targets: []*elbv2.TargetDescription{
+			&elbv2.TargetDescription{
+				Id: aws.String("i-1234abcd"),
+			},
+			&elbv2.TargetDescription{
+				Id: aws.String("i-5678efab"),
+			},
+		},
+	}).Return(&elbv2.RegisterTargetsOutput{}, nil)
+
+	client := &Client{
+		api: api,
+	}
+
+	targetGroupARN := "arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"
+	instanceIDs := []string{
+		"i-1234abcd",
+		"i-5678efab",
+	}
+
+	if err := client.RegisterInstances(ctx, targetGroupARN, instanceIDs); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
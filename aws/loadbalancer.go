@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// LoadBalancerKindTargetGroup represents target group of Application / Network Load Balancer
+	LoadBalancerKindTargetGroup = "target-group"
+	// LoadBalancerKindClassic represents Classic Load Balancer
+	LoadBalancerKindClassic = "classic"
+)
+
+// LoadBalancer represents load balancer which instances in ASG are attached to,
+// either target group of ALB / NLB or Classic Load Balancer
+type LoadBalancer interface {
+	// Kind returns LoadBalancerKindTargetGroup or LoadBalancerKindClassic
+	Kind() string
+	// ID returns target group ARN or Classic Load Balancer name
+	ID() string
+	DetachInstances(ctx context.Context, instanceIDs []string) error
+	ListInstanceHealth(ctx context.Context) (map[string]string, error)
+	ListInstances(ctx context.Context) ([]string, error)
+	RegisterInstances(ctx context.Context, instanceIDs []string) error
+	// DrainingTimeout returns how long connections to detached instances are kept after they are no longer listed
+	DrainingTimeout(ctx context.Context) (time.Duration, error)
+}
+
+// NewLoadBalancer returns the load balancer of the given kind and ID
+func NewLoadBalancer(kind, id string) (LoadBalancer, error) {
+	switch kind {
+	case LoadBalancerKindTargetGroup:
+		return &targetGroup{arn: id}, nil
+	case LoadBalancerKindClassic:
+		return &classicLoadBalancer{name: id}, nil
+	}
+
+	return nil, errors.Errorf("invalid load balancer kind %q", kind)
+}
+
+// RetrieveLoadBalancers retrieves all load balancers attached to the given ASG,
+// Classic Load Balancers first and then target groups
+// Empty slice is returned if nothing is attached
+func RetrieveLoadBalancers(ctx context.Context, groupName string) ([]LoadBalancer, error) {
+	names, err := AutoScaling.RetrieveLoadBalancers(ctx, groupName)
+	if err != nil {
+		return []LoadBalancer{}, err
+	}
+
+	arns, err := AutoScaling.RetrieveTargetGroups(ctx, groupName)
+	if err != nil {
+		return []LoadBalancer{}, err
+	}
+
+	lbs := []LoadBalancer{}
+
+	for _, name := range names {
+		lbs = append(lbs, &classicLoadBalancer{name: name})
+	}
+
+	for _, arn := range arns {
+		lbs = append(lbs, &targetGroup{arn: arn})
+	}
+
+	return lbs, nil
+}
+
+// targetGroup is LoadBalancer backed by ALB / NLB target group
+type targetGroup struct {
+	arn string
+}
+
+func (lb *targetGroup) Kind() string {
+	return LoadBalancerKindTargetGroup
+}
+
+func (lb *targetGroup) ID() string {
+	return lb.arn
+}
+
+func (lb *targetGroup) DetachInstances(ctx context.Context, instanceIDs []string) error {
+	return ELBv2.DetachInstances(ctx, lb.arn, instanceIDs)
+}
+
+func (lb *targetGroup) ListInstanceHealth(ctx context.Context) (map[string]string, error) {
+	return ELBv2.ListTargetHealth(ctx, lb.arn)
+}
+
+func (lb *targetGroup) ListInstances(ctx context.Context) ([]string, error) {
+	return ELBv2.ListTargetInstances(ctx, lb.arn)
+}
+
+func (lb *targetGroup) RegisterInstances(ctx context.Context, instanceIDs []string) error {
+	return ELBv2.RegisterInstances(ctx, lb.arn, instanceIDs)
+}
+
+// DrainingTimeout returns zero, because draining targets are listed until deregistration delay passes
+func (lb *targetGroup) DrainingTimeout(ctx context.Context) (time.Duration, error) {
+	return 0, nil
+}
+
+// classicLoadBalancer is LoadBalancer backed by Classic Load Balancer
+type classicLoadBalancer struct {
+	name string
+}
+
+func (lb *classicLoadBalancer) Kind() string {
+	return LoadBalancerKindClassic
+}
+
+func (lb *classicLoadBalancer) ID() string {
+	return lb.name
+}
+
+func (lb *classicLoadBalancer) DetachInstances(ctx context.Context, instanceIDs []string) error {
+	return ELB.DetachInstances(ctx, lb.name, instanceIDs)
+}
+
+func (lb *classicLoadBalancer) ListInstanceHealth(ctx context.Context) (map[string]string, error) {
+	return ELB.ListInstanceHealth(ctx, lb.name)
+}
+
+func (lb *classicLoadBalancer) ListInstances(ctx context.Context) ([]string, error) {
+	return ELB.ListInstances(ctx, lb.name)
+}
+
+func (lb *classicLoadBalancer) RegisterInstances(ctx context.Context, instanceIDs []string) error {
+	return ELB.RegisterInstances(ctx, lb.name, instanceIDs)
+}
+
+// DrainingTimeout returns connection draining timeout, because deregistered instances disappear from the list immediately
+func (lb *classicLoadBalancer) DrainingTimeout(ctx context.Context) (time.Duration, error) {
+	return ELB.RetrieveConnectionDrainingTimeout(ctx, lb.name)
+}
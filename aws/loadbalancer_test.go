@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	autoscalingapi "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/dtan4/esnctl/aws/autoscaling"
+	"github.com/dtan4/esnctl/aws/mock"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRetrieveLoadBalancers(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	targetGroupARN := "arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"
+
+	api := mock.NewMockAutoScalingAPI(ctrl)
+	api.EXPECT().DescribeLoadBalancersWithContext(ctx, &autoscalingapi.DescribeLoadBalancersInput{
+		AutoScalingGroupName: aws.String("elasticsearch"),
+	}).Return(&autoscalingapi.DescribeLoadBalancersOutput{
+		LoadBalancers: []*autoscalingapi.LoadBalancerState{
+			&autoscalingapi.LoadBalancerState{
+				LoadBalancerName: aws.String("elasticsearch"),
+			},
+		},
+	}, nil)
+	api.EXPECT().DescribeLoadBalancerTargetGroupsWithContext(ctx, &autoscalingapi.DescribeLoadBalancerTargetGroupsInput{
+		AutoScalingGroupName: aws.String("elasticsearch"),
+	}).Return(&autoscalingapi.DescribeLoadBalancerTargetGroupsOutput{
+		LoadBalancerTargetGroups: []*autoscalingapi.LoadBalancerTargetGroupState{
+			&autoscalingapi.LoadBalancerTargetGroupState{
+				LoadBalancerTargetGroupARN: aws.String(targetGroupARN),
+			},
+		},
+	}, nil)
+
+	AutoScaling = autoscaling.New(api)
+
+	lbs, err := RetrieveLoadBalancers(ctx, "elasticsearch")
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	expected := []struct {
+		kind string
+		id   string
+	}{
+		{LoadBalancerKindClassic, "elasticsearch"},
+		{LoadBalancerKindTargetGroup, targetGroupARN},
+	}
+
+	if len(lbs) != len(expected) {
+		t.Fatalf("number of load balancers does not match. expected: %d, got: %d", len(expected), len(lbs))
+	}
+
+	for i, e := range expected {
+		if lbs[i].Kind() != e.kind || lbs[i].ID() != e.id {
+			t.Errorf("load balancer does not match. expected: %s %q, got: %s %q", e.kind, e.id, lbs[i].Kind(), lbs[i].ID())
+		}
+	}
+}
+
+func TestRetrieveLoadBalancers_none(t *testing.T) {
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	api := mock.NewMockAutoScalingAPI(ctrl)
+	api.EXPECT().DescribeLoadBalancersWithContext(ctx, &autoscalingapi.DescribeLoadBalancersInput{
+		AutoScalingGroupName: aws.String("elasticsearch"),
+	}).Return(&autoscalingapi.DescribeLoadBalancersOutput{
+		LoadBalancers: []*autoscalingapi.LoadBalancerState{},
+	}, nil)
+	api.EXPECT().DescribeLoadBalancerTargetGroupsWithContext(ctx, &autoscalingapi.DescribeLoadBalancerTargetGroupsInput{
+		AutoScalingGroupName: aws.String("elasticsearch"),
+	}).Return(&autoscalingapi.DescribeLoadBalancerTargetGroupsOutput{
+		LoadBalancerTargetGroups: []*autoscalingapi.LoadBalancerTargetGroupState{},
+	}, nil)
+
+	AutoScaling = autoscaling.New(api)
+
+	lbs, err := RetrieveLoadBalancers(ctx, "elasticsearch")
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	if len(lbs) != 0 {
+		t.Errorf("no load balancer should be returned, got: %d", len(lbs))
+	}
+}
+
+func TestNewLoadBalancer(t *testing.T) {
+	for _, kind := range []string{LoadBalancerKindTargetGroup, LoadBalancerKindClassic} {
+		lb, err := NewLoadBalancer(kind, "elasticsearch")
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+			continue
+		}
+
+		if lb.Kind() != kind {
+			t.Errorf("kind does not match. expected: %s, got: %s", kind, lb.Kind())
+		}
+	}
+
+	if _, err := NewLoadBalancer("gateway", "elasticsearch"); err == nil {
+		t.Errorf("error should be raised for invalid kind")
+	}
+}
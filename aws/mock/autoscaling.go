@@ -4,6 +4,7 @@
 package mock
 
 import (
+	aws "github.com/aws/aws-sdk-go/aws"
 	request "github.com/aws/aws-sdk-go/aws/request"
 	autoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
 	gomock "github.com/golang/mock/gomock"
@@ -52,6 +53,22 @@ func (_mr *_MockAutoScalingAPIRecorder) AttachInstances(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachInstances", arg0)
 }
 
+func (_m *MockAutoScalingAPI) AttachInstancesWithContext(_param0 aws.Context, _param1 *autoscaling.AttachInstancesInput, _param2 ...request.Option) (*autoscaling.AttachInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.AttachInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) AttachInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachInstancesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) AttachLoadBalancerTargetGroupsRequest(_param0 *autoscaling.AttachLoadBalancerTargetGroupsInput) (*request.Request, *autoscaling.AttachLoadBalancerTargetGroupsOutput) {
 	ret := _m.ctrl.Call(_m, "AttachLoadBalancerTargetGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -74,6 +91,22 @@ func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancerTargetGroups(arg0 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancerTargetGroups", arg0)
 }
 
+func (_m *MockAutoScalingAPI) AttachLoadBalancerTargetGroupsWithContext(_param0 aws.Context, _param1 *autoscaling.AttachLoadBalancerTargetGroupsInput, _param2 ...request.Option) (*autoscaling.AttachLoadBalancerTargetGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachLoadBalancerTargetGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.AttachLoadBalancerTargetGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancerTargetGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancerTargetGroupsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) AttachLoadBalancersRequest(_param0 *autoscaling.AttachLoadBalancersInput) (*request.Request, *autoscaling.AttachLoadBalancersOutput) {
 	ret := _m.ctrl.Call(_m, "AttachLoadBalancersRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -96,6 +129,22 @@ func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancers(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancers", arg0)
 }
 
+func (_m *MockAutoScalingAPI) AttachLoadBalancersWithContext(_param0 aws.Context, _param1 *autoscaling.AttachLoadBalancersInput, _param2 ...request.Option) (*autoscaling.AttachLoadBalancersOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachLoadBalancersWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.AttachLoadBalancersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) AttachLoadBalancersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachLoadBalancersWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) CompleteLifecycleActionRequest(_param0 *autoscaling.CompleteLifecycleActionInput) (*request.Request, *autoscaling.CompleteLifecycleActionOutput) {
 	ret := _m.ctrl.Call(_m, "CompleteLifecycleActionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -118,6 +167,22 @@ func (_mr *_MockAutoScalingAPIRecorder) CompleteLifecycleAction(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CompleteLifecycleAction", arg0)
 }
 
+func (_m *MockAutoScalingAPI) CompleteLifecycleActionWithContext(_param0 aws.Context, _param1 *autoscaling.CompleteLifecycleActionInput, _param2 ...request.Option) (*autoscaling.CompleteLifecycleActionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CompleteLifecycleActionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.CompleteLifecycleActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) CompleteLifecycleActionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CompleteLifecycleActionWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) CreateAutoScalingGroupRequest(_param0 *autoscaling.CreateAutoScalingGroupInput) (*request.Request, *autoscaling.CreateAutoScalingGroupOutput) {
 	ret := _m.ctrl.Call(_m, "CreateAutoScalingGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -140,6 +205,22 @@ func (_mr *_MockAutoScalingAPIRecorder) CreateAutoScalingGroup(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAutoScalingGroup", arg0)
 }
 
+func (_m *MockAutoScalingAPI) CreateAutoScalingGroupWithContext(_param0 aws.Context, _param1 *autoscaling.CreateAutoScalingGroupInput, _param2 ...request.Option) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateAutoScalingGroupWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.CreateAutoScalingGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) CreateAutoScalingGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateAutoScalingGroupWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) CreateLaunchConfigurationRequest(_param0 *autoscaling.CreateLaunchConfigurationInput) (*request.Request, *autoscaling.CreateLaunchConfigurationOutput) {
 	ret := _m.ctrl.Call(_m, "CreateLaunchConfigurationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -162,6 +243,22 @@ func (_mr *_MockAutoScalingAPIRecorder) CreateLaunchConfiguration(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateLaunchConfiguration", arg0)
 }
 
+func (_m *MockAutoScalingAPI) CreateLaunchConfigurationWithContext(_param0 aws.Context, _param1 *autoscaling.CreateLaunchConfigurationInput, _param2 ...request.Option) (*autoscaling.CreateLaunchConfigurationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateLaunchConfigurationWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.CreateLaunchConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) CreateLaunchConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateLaunchConfigurationWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) CreateOrUpdateTagsRequest(_param0 *autoscaling.CreateOrUpdateTagsInput) (*request.Request, *autoscaling.CreateOrUpdateTagsOutput) {
 	ret := _m.ctrl.Call(_m, "CreateOrUpdateTagsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -184,6 +281,22 @@ func (_mr *_MockAutoScalingAPIRecorder) CreateOrUpdateTags(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateOrUpdateTags", arg0)
 }
 
+func (_m *MockAutoScalingAPI) CreateOrUpdateTagsWithContext(_param0 aws.Context, _param1 *autoscaling.CreateOrUpdateTagsInput, _param2 ...request.Option) (*autoscaling.CreateOrUpdateTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateOrUpdateTagsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.CreateOrUpdateTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) CreateOrUpdateTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateOrUpdateTagsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DeleteAutoScalingGroupRequest(_param0 *autoscaling.DeleteAutoScalingGroupInput) (*request.Request, *autoscaling.DeleteAutoScalingGroupOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteAutoScalingGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -206,6 +319,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteAutoScalingGroup(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteAutoScalingGroup", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DeleteAutoScalingGroupWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteAutoScalingGroupInput, _param2 ...request.Option) (*autoscaling.DeleteAutoScalingGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteAutoScalingGroupWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteAutoScalingGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteAutoScalingGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteAutoScalingGroupWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DeleteLaunchConfigurationRequest(_param0 *autoscaling.DeleteLaunchConfigurationInput) (*request.Request, *autoscaling.DeleteLaunchConfigurationOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteLaunchConfigurationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -228,6 +357,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteLaunchConfiguration(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLaunchConfiguration", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DeleteLaunchConfigurationWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteLaunchConfigurationInput, _param2 ...request.Option) (*autoscaling.DeleteLaunchConfigurationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteLaunchConfigurationWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteLaunchConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteLaunchConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLaunchConfigurationWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DeleteLifecycleHookRequest(_param0 *autoscaling.DeleteLifecycleHookInput) (*request.Request, *autoscaling.DeleteLifecycleHookOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteLifecycleHookRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -250,6 +395,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteLifecycleHook(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLifecycleHook", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DeleteLifecycleHookWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteLifecycleHookInput, _param2 ...request.Option) (*autoscaling.DeleteLifecycleHookOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteLifecycleHookWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteLifecycleHookOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteLifecycleHookWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLifecycleHookWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DeleteNotificationConfigurationRequest(_param0 *autoscaling.DeleteNotificationConfigurationInput) (*request.Request, *autoscaling.DeleteNotificationConfigurationOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteNotificationConfigurationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -272,6 +433,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteNotificationConfiguration(arg0 int
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNotificationConfiguration", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DeleteNotificationConfigurationWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteNotificationConfigurationInput, _param2 ...request.Option) (*autoscaling.DeleteNotificationConfigurationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteNotificationConfigurationWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteNotificationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteNotificationConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNotificationConfigurationWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DeletePolicyRequest(_param0 *autoscaling.DeletePolicyInput) (*request.Request, *autoscaling.DeletePolicyOutput) {
 	ret := _m.ctrl.Call(_m, "DeletePolicyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -294,6 +471,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DeletePolicy(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePolicy", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DeletePolicyWithContext(_param0 aws.Context, _param1 *autoscaling.DeletePolicyInput, _param2 ...request.Option) (*autoscaling.DeletePolicyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeletePolicyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeletePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeletePolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePolicyWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DeleteScheduledActionRequest(_param0 *autoscaling.DeleteScheduledActionInput) (*request.Request, *autoscaling.DeleteScheduledActionOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteScheduledActionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -316,6 +509,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteScheduledAction(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteScheduledAction", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DeleteScheduledActionWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteScheduledActionInput, _param2 ...request.Option) (*autoscaling.DeleteScheduledActionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteScheduledActionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteScheduledActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteScheduledActionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteScheduledActionWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DeleteTagsRequest(_param0 *autoscaling.DeleteTagsInput) (*request.Request, *autoscaling.DeleteTagsOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteTagsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -338,6 +547,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DeleteTags(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTags", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DeleteTagsWithContext(_param0 aws.Context, _param1 *autoscaling.DeleteTagsInput, _param2 ...request.Option) (*autoscaling.DeleteTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteTagsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DeleteTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DeleteTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTagsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeAccountLimitsRequest(_param0 *autoscaling.DescribeAccountLimitsInput) (*request.Request, *autoscaling.DescribeAccountLimitsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeAccountLimitsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -360,6 +585,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAccountLimits(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAccountLimits", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeAccountLimitsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAccountLimitsInput, _param2 ...request.Option) (*autoscaling.DescribeAccountLimitsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAccountLimitsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAccountLimitsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAccountLimitsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAccountLimitsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeAdjustmentTypesRequest(_param0 *autoscaling.DescribeAdjustmentTypesInput) (*request.Request, *autoscaling.DescribeAdjustmentTypesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeAdjustmentTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -382,6 +623,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAdjustmentTypes(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAdjustmentTypes", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeAdjustmentTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAdjustmentTypesInput, _param2 ...request.Option) (*autoscaling.DescribeAdjustmentTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAdjustmentTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAdjustmentTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAdjustmentTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAdjustmentTypesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeAutoScalingGroupsRequest(_param0 *autoscaling.DescribeAutoScalingGroupsInput) (*request.Request, *autoscaling.DescribeAutoScalingGroupsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeAutoScalingGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -404,6 +661,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroups(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroups", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeAutoScalingGroupsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 ...request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAutoScalingGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroupsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeAutoScalingGroupsPages(_param0 *autoscaling.DescribeAutoScalingGroupsInput, _param1 func(*autoscaling.DescribeAutoScalingGroupsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeAutoScalingGroupsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -414,6 +687,21 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroupsPages(arg0, arg
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroupsPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribeAutoScalingGroupsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 func(*autoscaling.DescribeAutoScalingGroupsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingGroupsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingGroupsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingGroupsPagesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeAutoScalingInstancesRequest(_param0 *autoscaling.DescribeAutoScalingInstancesInput) (*request.Request, *autoscaling.DescribeAutoScalingInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeAutoScalingInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -436,6 +724,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstances(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstances", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeAutoScalingInstancesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingInstancesInput, _param2 ...request.Option) (*autoscaling.DescribeAutoScalingInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAutoScalingInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstancesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeAutoScalingInstancesPages(_param0 *autoscaling.DescribeAutoScalingInstancesInput, _param1 func(*autoscaling.DescribeAutoScalingInstancesOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeAutoScalingInstancesPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -446,6 +750,21 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstancesPages(arg0,
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstancesPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribeAutoScalingInstancesPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingInstancesInput, _param2 func(*autoscaling.DescribeAutoScalingInstancesOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingInstancesPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingInstancesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingInstancesPagesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeAutoScalingNotificationTypesRequest(_param0 *autoscaling.DescribeAutoScalingNotificationTypesInput) (*request.Request, *autoscaling.DescribeAutoScalingNotificationTypesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeAutoScalingNotificationTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -468,6 +787,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingNotificationTypes(arg
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingNotificationTypes", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeAutoScalingNotificationTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingNotificationTypesInput, _param2 ...request.Option) (*autoscaling.DescribeAutoScalingNotificationTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAutoScalingNotificationTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeAutoScalingNotificationTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeAutoScalingNotificationTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAutoScalingNotificationTypesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeLaunchConfigurationsRequest(_param0 *autoscaling.DescribeLaunchConfigurationsInput) (*request.Request, *autoscaling.DescribeLaunchConfigurationsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeLaunchConfigurationsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -490,6 +825,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurations(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurations", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeLaunchConfigurationsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLaunchConfigurationsInput, _param2 ...request.Option) (*autoscaling.DescribeLaunchConfigurationsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLaunchConfigurationsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLaunchConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurationsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeLaunchConfigurationsPages(_param0 *autoscaling.DescribeLaunchConfigurationsInput, _param1 func(*autoscaling.DescribeLaunchConfigurationsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeLaunchConfigurationsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -500,6 +851,21 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurationsPages(arg0,
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurationsPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribeLaunchConfigurationsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLaunchConfigurationsInput, _param2 func(*autoscaling.DescribeLaunchConfigurationsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLaunchConfigurationsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLaunchConfigurationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLaunchConfigurationsPagesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeLifecycleHookTypesRequest(_param0 *autoscaling.DescribeLifecycleHookTypesInput) (*request.Request, *autoscaling.DescribeLifecycleHookTypesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeLifecycleHookTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -522,6 +888,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHookTypes(arg0 interfac
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHookTypes", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeLifecycleHookTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLifecycleHookTypesInput, _param2 ...request.Option) (*autoscaling.DescribeLifecycleHookTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLifecycleHookTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLifecycleHookTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHookTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHookTypesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeLifecycleHooksRequest(_param0 *autoscaling.DescribeLifecycleHooksInput) (*request.Request, *autoscaling.DescribeLifecycleHooksOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeLifecycleHooksRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -544,6 +926,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHooks(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHooks", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeLifecycleHooksWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLifecycleHooksInput, _param2 ...request.Option) (*autoscaling.DescribeLifecycleHooksOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLifecycleHooksWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLifecycleHooksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLifecycleHooksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLifecycleHooksWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeLoadBalancerTargetGroupsRequest(_param0 *autoscaling.DescribeLoadBalancerTargetGroupsInput) (*request.Request, *autoscaling.DescribeLoadBalancerTargetGroupsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeLoadBalancerTargetGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -566,6 +964,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancerTargetGroups(arg0 in
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancerTargetGroups", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeLoadBalancerTargetGroupsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLoadBalancerTargetGroupsInput, _param2 ...request.Option) (*autoscaling.DescribeLoadBalancerTargetGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancerTargetGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLoadBalancerTargetGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancerTargetGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancerTargetGroupsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeLoadBalancersRequest(_param0 *autoscaling.DescribeLoadBalancersInput) (*request.Request, *autoscaling.DescribeLoadBalancersOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeLoadBalancersRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -588,6 +1002,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancers(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancers", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeLoadBalancersWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeLoadBalancersInput, _param2 ...request.Option) (*autoscaling.DescribeLoadBalancersOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancersWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeLoadBalancersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeLoadBalancersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancersWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeMetricCollectionTypesRequest(_param0 *autoscaling.DescribeMetricCollectionTypesInput) (*request.Request, *autoscaling.DescribeMetricCollectionTypesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeMetricCollectionTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -610,6 +1040,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeMetricCollectionTypes(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMetricCollectionTypes", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeMetricCollectionTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeMetricCollectionTypesInput, _param2 ...request.Option) (*autoscaling.DescribeMetricCollectionTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeMetricCollectionTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeMetricCollectionTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeMetricCollectionTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMetricCollectionTypesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeNotificationConfigurationsRequest(_param0 *autoscaling.DescribeNotificationConfigurationsInput) (*request.Request, *autoscaling.DescribeNotificationConfigurationsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeNotificationConfigurationsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -632,6 +1078,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurations(arg0
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurations", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeNotificationConfigurationsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeNotificationConfigurationsInput, _param2 ...request.Option) (*autoscaling.DescribeNotificationConfigurationsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeNotificationConfigurationsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeNotificationConfigurationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurationsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeNotificationConfigurationsPages(_param0 *autoscaling.DescribeNotificationConfigurationsInput, _param1 func(*autoscaling.DescribeNotificationConfigurationsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeNotificationConfigurationsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -642,6 +1104,21 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurationsPages(
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurationsPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribeNotificationConfigurationsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeNotificationConfigurationsInput, _param2 func(*autoscaling.DescribeNotificationConfigurationsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeNotificationConfigurationsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeNotificationConfigurationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNotificationConfigurationsPagesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribePoliciesRequest(_param0 *autoscaling.DescribePoliciesInput) (*request.Request, *autoscaling.DescribePoliciesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribePoliciesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -664,6 +1141,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribePolicies(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePolicies", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribePoliciesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribePoliciesInput, _param2 ...request.Option) (*autoscaling.DescribePoliciesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribePoliciesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribePoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribePoliciesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePoliciesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribePoliciesPages(_param0 *autoscaling.DescribePoliciesInput, _param1 func(*autoscaling.DescribePoliciesOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribePoliciesPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -674,6 +1167,21 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribePoliciesPages(arg0, arg1 interfa
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePoliciesPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribePoliciesPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribePoliciesInput, _param2 func(*autoscaling.DescribePoliciesOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribePoliciesPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribePoliciesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePoliciesPagesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeScalingActivitiesRequest(_param0 *autoscaling.DescribeScalingActivitiesInput) (*request.Request, *autoscaling.DescribeScalingActivitiesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeScalingActivitiesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -696,6 +1204,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivities(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivities", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeScalingActivitiesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScalingActivitiesInput, _param2 ...request.Option) (*autoscaling.DescribeScalingActivitiesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScalingActivitiesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeScalingActivitiesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivitiesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivitiesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeScalingActivitiesPages(_param0 *autoscaling.DescribeScalingActivitiesInput, _param1 func(*autoscaling.DescribeScalingActivitiesOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeScalingActivitiesPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -706,6 +1230,21 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivitiesPages(arg0, arg
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivitiesPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribeScalingActivitiesPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScalingActivitiesInput, _param2 func(*autoscaling.DescribeScalingActivitiesOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScalingActivitiesPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingActivitiesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingActivitiesPagesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeScalingProcessTypesRequest(_param0 *autoscaling.DescribeScalingProcessTypesInput) (*request.Request, *autoscaling.DescribeScalingProcessTypesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeScalingProcessTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -728,6 +1267,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingProcessTypes(arg0 interfa
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingProcessTypes", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeScalingProcessTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScalingProcessTypesInput, _param2 ...request.Option) (*autoscaling.DescribeScalingProcessTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScalingProcessTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeScalingProcessTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScalingProcessTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScalingProcessTypesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeScheduledActionsRequest(_param0 *autoscaling.DescribeScheduledActionsInput) (*request.Request, *autoscaling.DescribeScheduledActionsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeScheduledActionsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -750,6 +1305,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActions(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActions", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeScheduledActionsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScheduledActionsInput, _param2 ...request.Option) (*autoscaling.DescribeScheduledActionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScheduledActionsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeScheduledActionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActionsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeScheduledActionsPages(_param0 *autoscaling.DescribeScheduledActionsInput, _param1 func(*autoscaling.DescribeScheduledActionsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeScheduledActionsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -760,6 +1331,21 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActionsPages(arg0, arg1
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActionsPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribeScheduledActionsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeScheduledActionsInput, _param2 func(*autoscaling.DescribeScheduledActionsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScheduledActionsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeScheduledActionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledActionsPagesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeTagsRequest(_param0 *autoscaling.DescribeTagsInput) (*request.Request, *autoscaling.DescribeTagsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeTagsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -782,6 +1368,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeTags(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTags", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeTagsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeTagsInput, _param2 ...request.Option) (*autoscaling.DescribeTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTagsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeTagsPages(_param0 *autoscaling.DescribeTagsInput, _param1 func(*autoscaling.DescribeTagsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeTagsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -792,6 +1394,21 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeTagsPages(arg0, arg1 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsPages", arg0, arg1)
 }
 
+func (_m *MockAutoScalingAPI) DescribeTagsPagesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeTagsInput, _param2 func(*autoscaling.DescribeTagsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTagsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeTagsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsPagesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DescribeTerminationPolicyTypesRequest(_param0 *autoscaling.DescribeTerminationPolicyTypesInput) (*request.Request, *autoscaling.DescribeTerminationPolicyTypesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeTerminationPolicyTypesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -814,6 +1431,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DescribeTerminationPolicyTypes(arg0 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTerminationPolicyTypes", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DescribeTerminationPolicyTypesWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeTerminationPolicyTypesInput, _param2 ...request.Option) (*autoscaling.DescribeTerminationPolicyTypesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTerminationPolicyTypesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DescribeTerminationPolicyTypesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DescribeTerminationPolicyTypesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTerminationPolicyTypesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DetachInstancesRequest(_param0 *autoscaling.DetachInstancesInput) (*request.Request, *autoscaling.DetachInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "DetachInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -836,6 +1469,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DetachInstances(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachInstances", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DetachInstancesWithContext(_param0 aws.Context, _param1 *autoscaling.DetachInstancesInput, _param2 ...request.Option) (*autoscaling.DetachInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DetachInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DetachInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachInstancesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DetachLoadBalancerTargetGroupsRequest(_param0 *autoscaling.DetachLoadBalancerTargetGroupsInput) (*request.Request, *autoscaling.DetachLoadBalancerTargetGroupsOutput) {
 	ret := _m.ctrl.Call(_m, "DetachLoadBalancerTargetGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -858,6 +1507,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancerTargetGroups(arg0 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancerTargetGroups", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DetachLoadBalancerTargetGroupsWithContext(_param0 aws.Context, _param1 *autoscaling.DetachLoadBalancerTargetGroupsInput, _param2 ...request.Option) (*autoscaling.DetachLoadBalancerTargetGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachLoadBalancerTargetGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DetachLoadBalancerTargetGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancerTargetGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancerTargetGroupsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DetachLoadBalancersRequest(_param0 *autoscaling.DetachLoadBalancersInput) (*request.Request, *autoscaling.DetachLoadBalancersOutput) {
 	ret := _m.ctrl.Call(_m, "DetachLoadBalancersRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -880,6 +1545,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancers(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancers", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DetachLoadBalancersWithContext(_param0 aws.Context, _param1 *autoscaling.DetachLoadBalancersInput, _param2 ...request.Option) (*autoscaling.DetachLoadBalancersOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachLoadBalancersWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DetachLoadBalancersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DetachLoadBalancersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachLoadBalancersWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) DisableMetricsCollectionRequest(_param0 *autoscaling.DisableMetricsCollectionInput) (*request.Request, *autoscaling.DisableMetricsCollectionOutput) {
 	ret := _m.ctrl.Call(_m, "DisableMetricsCollectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -902,6 +1583,22 @@ func (_mr *_MockAutoScalingAPIRecorder) DisableMetricsCollection(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableMetricsCollection", arg0)
 }
 
+func (_m *MockAutoScalingAPI) DisableMetricsCollectionWithContext(_param0 aws.Context, _param1 *autoscaling.DisableMetricsCollectionInput, _param2 ...request.Option) (*autoscaling.DisableMetricsCollectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisableMetricsCollectionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.DisableMetricsCollectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) DisableMetricsCollectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableMetricsCollectionWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) EnableMetricsCollectionRequest(_param0 *autoscaling.EnableMetricsCollectionInput) (*request.Request, *autoscaling.EnableMetricsCollectionOutput) {
 	ret := _m.ctrl.Call(_m, "EnableMetricsCollectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -924,6 +1621,22 @@ func (_mr *_MockAutoScalingAPIRecorder) EnableMetricsCollection(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableMetricsCollection", arg0)
 }
 
+func (_m *MockAutoScalingAPI) EnableMetricsCollectionWithContext(_param0 aws.Context, _param1 *autoscaling.EnableMetricsCollectionInput, _param2 ...request.Option) (*autoscaling.EnableMetricsCollectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "EnableMetricsCollectionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.EnableMetricsCollectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) EnableMetricsCollectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableMetricsCollectionWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) EnterStandbyRequest(_param0 *autoscaling.EnterStandbyInput) (*request.Request, *autoscaling.EnterStandbyOutput) {
 	ret := _m.ctrl.Call(_m, "EnterStandbyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -946,6 +1659,22 @@ func (_mr *_MockAutoScalingAPIRecorder) EnterStandby(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnterStandby", arg0)
 }
 
+func (_m *MockAutoScalingAPI) EnterStandbyWithContext(_param0 aws.Context, _param1 *autoscaling.EnterStandbyInput, _param2 ...request.Option) (*autoscaling.EnterStandbyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "EnterStandbyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.EnterStandbyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) EnterStandbyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnterStandbyWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) ExecutePolicyRequest(_param0 *autoscaling.ExecutePolicyInput) (*request.Request, *autoscaling.ExecutePolicyOutput) {
 	ret := _m.ctrl.Call(_m, "ExecutePolicyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -968,6 +1697,22 @@ func (_mr *_MockAutoScalingAPIRecorder) ExecutePolicy(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExecutePolicy", arg0)
 }
 
+func (_m *MockAutoScalingAPI) ExecutePolicyWithContext(_param0 aws.Context, _param1 *autoscaling.ExecutePolicyInput, _param2 ...request.Option) (*autoscaling.ExecutePolicyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ExecutePolicyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.ExecutePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) ExecutePolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExecutePolicyWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) ExitStandbyRequest(_param0 *autoscaling.ExitStandbyInput) (*request.Request, *autoscaling.ExitStandbyOutput) {
 	ret := _m.ctrl.Call(_m, "ExitStandbyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -990,6 +1735,22 @@ func (_mr *_MockAutoScalingAPIRecorder) ExitStandby(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExitStandby", arg0)
 }
 
+func (_m *MockAutoScalingAPI) ExitStandbyWithContext(_param0 aws.Context, _param1 *autoscaling.ExitStandbyInput, _param2 ...request.Option) (*autoscaling.ExitStandbyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ExitStandbyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.ExitStandbyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) ExitStandbyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExitStandbyWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) PutLifecycleHookRequest(_param0 *autoscaling.PutLifecycleHookInput) (*request.Request, *autoscaling.PutLifecycleHookOutput) {
 	ret := _m.ctrl.Call(_m, "PutLifecycleHookRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1012,6 +1773,22 @@ func (_mr *_MockAutoScalingAPIRecorder) PutLifecycleHook(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutLifecycleHook", arg0)
 }
 
+func (_m *MockAutoScalingAPI) PutLifecycleHookWithContext(_param0 aws.Context, _param1 *autoscaling.PutLifecycleHookInput, _param2 ...request.Option) (*autoscaling.PutLifecycleHookOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PutLifecycleHookWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.PutLifecycleHookOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) PutLifecycleHookWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutLifecycleHookWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) PutNotificationConfigurationRequest(_param0 *autoscaling.PutNotificationConfigurationInput) (*request.Request, *autoscaling.PutNotificationConfigurationOutput) {
 	ret := _m.ctrl.Call(_m, "PutNotificationConfigurationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1034,6 +1811,22 @@ func (_mr *_MockAutoScalingAPIRecorder) PutNotificationConfiguration(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutNotificationConfiguration", arg0)
 }
 
+func (_m *MockAutoScalingAPI) PutNotificationConfigurationWithContext(_param0 aws.Context, _param1 *autoscaling.PutNotificationConfigurationInput, _param2 ...request.Option) (*autoscaling.PutNotificationConfigurationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PutNotificationConfigurationWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.PutNotificationConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) PutNotificationConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutNotificationConfigurationWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) PutScalingPolicyRequest(_param0 *autoscaling.PutScalingPolicyInput) (*request.Request, *autoscaling.PutScalingPolicyOutput) {
 	ret := _m.ctrl.Call(_m, "PutScalingPolicyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1056,6 +1849,22 @@ func (_mr *_MockAutoScalingAPIRecorder) PutScalingPolicy(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScalingPolicy", arg0)
 }
 
+func (_m *MockAutoScalingAPI) PutScalingPolicyWithContext(_param0 aws.Context, _param1 *autoscaling.PutScalingPolicyInput, _param2 ...request.Option) (*autoscaling.PutScalingPolicyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PutScalingPolicyWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.PutScalingPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) PutScalingPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScalingPolicyWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) PutScheduledUpdateGroupActionRequest(_param0 *autoscaling.PutScheduledUpdateGroupActionInput) (*request.Request, *autoscaling.PutScheduledUpdateGroupActionOutput) {
 	ret := _m.ctrl.Call(_m, "PutScheduledUpdateGroupActionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1078,6 +1887,22 @@ func (_mr *_MockAutoScalingAPIRecorder) PutScheduledUpdateGroupAction(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScheduledUpdateGroupAction", arg0)
 }
 
+func (_m *MockAutoScalingAPI) PutScheduledUpdateGroupActionWithContext(_param0 aws.Context, _param1 *autoscaling.PutScheduledUpdateGroupActionInput, _param2 ...request.Option) (*autoscaling.PutScheduledUpdateGroupActionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PutScheduledUpdateGroupActionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.PutScheduledUpdateGroupActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) PutScheduledUpdateGroupActionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PutScheduledUpdateGroupActionWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) RecordLifecycleActionHeartbeatRequest(_param0 *autoscaling.RecordLifecycleActionHeartbeatInput) (*request.Request, *autoscaling.RecordLifecycleActionHeartbeatOutput) {
 	ret := _m.ctrl.Call(_m, "RecordLifecycleActionHeartbeatRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1100,6 +1925,22 @@ func (_mr *_MockAutoScalingAPIRecorder) RecordLifecycleActionHeartbeat(arg0 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RecordLifecycleActionHeartbeat", arg0)
 }
 
+func (_m *MockAutoScalingAPI) RecordLifecycleActionHeartbeatWithContext(_param0 aws.Context, _param1 *autoscaling.RecordLifecycleActionHeartbeatInput, _param2 ...request.Option) (*autoscaling.RecordLifecycleActionHeartbeatOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RecordLifecycleActionHeartbeatWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.RecordLifecycleActionHeartbeatOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) RecordLifecycleActionHeartbeatWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RecordLifecycleActionHeartbeatWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) ResumeProcessesRequest(_param0 *autoscaling.ScalingProcessQuery) (*request.Request, *autoscaling.ResumeProcessesOutput) {
 	ret := _m.ctrl.Call(_m, "ResumeProcessesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1122,6 +1963,22 @@ func (_mr *_MockAutoScalingAPIRecorder) ResumeProcesses(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResumeProcesses", arg0)
 }
 
+func (_m *MockAutoScalingAPI) ResumeProcessesWithContext(_param0 aws.Context, _param1 *autoscaling.ScalingProcessQuery, _param2 ...request.Option) (*autoscaling.ResumeProcessesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ResumeProcessesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.ResumeProcessesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) ResumeProcessesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResumeProcessesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) SetDesiredCapacityRequest(_param0 *autoscaling.SetDesiredCapacityInput) (*request.Request, *autoscaling.SetDesiredCapacityOutput) {
 	ret := _m.ctrl.Call(_m, "SetDesiredCapacityRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1144,6 +2001,22 @@ func (_mr *_MockAutoScalingAPIRecorder) SetDesiredCapacity(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetDesiredCapacity", arg0)
 }
 
+func (_m *MockAutoScalingAPI) SetDesiredCapacityWithContext(_param0 aws.Context, _param1 *autoscaling.SetDesiredCapacityInput, _param2 ...request.Option) (*autoscaling.SetDesiredCapacityOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetDesiredCapacityWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.SetDesiredCapacityOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) SetDesiredCapacityWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetDesiredCapacityWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) SetInstanceHealthRequest(_param0 *autoscaling.SetInstanceHealthInput) (*request.Request, *autoscaling.SetInstanceHealthOutput) {
 	ret := _m.ctrl.Call(_m, "SetInstanceHealthRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1166,6 +2039,22 @@ func (_mr *_MockAutoScalingAPIRecorder) SetInstanceHealth(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceHealth", arg0)
 }
 
+func (_m *MockAutoScalingAPI) SetInstanceHealthWithContext(_param0 aws.Context, _param1 *autoscaling.SetInstanceHealthInput, _param2 ...request.Option) (*autoscaling.SetInstanceHealthOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetInstanceHealthWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.SetInstanceHealthOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) SetInstanceHealthWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceHealthWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) SetInstanceProtectionRequest(_param0 *autoscaling.SetInstanceProtectionInput) (*request.Request, *autoscaling.SetInstanceProtectionOutput) {
 	ret := _m.ctrl.Call(_m, "SetInstanceProtectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1188,6 +2077,22 @@ func (_mr *_MockAutoScalingAPIRecorder) SetInstanceProtection(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceProtection", arg0)
 }
 
+func (_m *MockAutoScalingAPI) SetInstanceProtectionWithContext(_param0 aws.Context, _param1 *autoscaling.SetInstanceProtectionInput, _param2 ...request.Option) (*autoscaling.SetInstanceProtectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetInstanceProtectionWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.SetInstanceProtectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) SetInstanceProtectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInstanceProtectionWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) SuspendProcessesRequest(_param0 *autoscaling.ScalingProcessQuery) (*request.Request, *autoscaling.SuspendProcessesOutput) {
 	ret := _m.ctrl.Call(_m, "SuspendProcessesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1210,6 +2115,22 @@ func (_mr *_MockAutoScalingAPIRecorder) SuspendProcesses(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SuspendProcesses", arg0)
 }
 
+func (_m *MockAutoScalingAPI) SuspendProcessesWithContext(_param0 aws.Context, _param1 *autoscaling.ScalingProcessQuery, _param2 ...request.Option) (*autoscaling.SuspendProcessesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SuspendProcessesWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.SuspendProcessesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) SuspendProcessesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SuspendProcessesWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) TerminateInstanceInAutoScalingGroupRequest(_param0 *autoscaling.TerminateInstanceInAutoScalingGroupInput) (*request.Request, *autoscaling.TerminateInstanceInAutoScalingGroupOutput) {
 	ret := _m.ctrl.Call(_m, "TerminateInstanceInAutoScalingGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1232,6 +2153,22 @@ func (_mr *_MockAutoScalingAPIRecorder) TerminateInstanceInAutoScalingGroup(arg0
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "TerminateInstanceInAutoScalingGroup", arg0)
 }
 
+func (_m *MockAutoScalingAPI) TerminateInstanceInAutoScalingGroupWithContext(_param0 aws.Context, _param1 *autoscaling.TerminateInstanceInAutoScalingGroupInput, _param2 ...request.Option) (*autoscaling.TerminateInstanceInAutoScalingGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "TerminateInstanceInAutoScalingGroupWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.TerminateInstanceInAutoScalingGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) TerminateInstanceInAutoScalingGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "TerminateInstanceInAutoScalingGroupWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) UpdateAutoScalingGroupRequest(_param0 *autoscaling.UpdateAutoScalingGroupInput) (*request.Request, *autoscaling.UpdateAutoScalingGroupOutput) {
 	ret := _m.ctrl.Call(_m, "UpdateAutoScalingGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1254,6 +2191,22 @@ func (_mr *_MockAutoScalingAPIRecorder) UpdateAutoScalingGroup(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAutoScalingGroup", arg0)
 }
 
+func (_m *MockAutoScalingAPI) UpdateAutoScalingGroupWithContext(_param0 aws.Context, _param1 *autoscaling.UpdateAutoScalingGroupInput, _param2 ...request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "UpdateAutoScalingGroupWithContext", _s...)
+	ret0, _ := ret[0].(*autoscaling.UpdateAutoScalingGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) UpdateAutoScalingGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateAutoScalingGroupWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) WaitUntilGroupExists(_param0 *autoscaling.DescribeAutoScalingGroupsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilGroupExists", _param0)
 	ret0, _ := ret[0].(error)
@@ -1264,6 +2217,21 @@ func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupExists(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupExists", arg0)
 }
 
+func (_m *MockAutoScalingAPI) WaitUntilGroupExistsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilGroupExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupExistsWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) WaitUntilGroupInService(_param0 *autoscaling.DescribeAutoScalingGroupsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilGroupInService", _param0)
 	ret0, _ := ret[0].(error)
@@ -1274,6 +2242,21 @@ func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupInService(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupInService", arg0)
 }
 
+func (_m *MockAutoScalingAPI) WaitUntilGroupInServiceWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilGroupInServiceWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupInServiceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupInServiceWithContext", _s...)
+}
+
 func (_m *MockAutoScalingAPI) WaitUntilGroupNotExists(_param0 *autoscaling.DescribeAutoScalingGroupsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilGroupNotExists", _param0)
 	ret0, _ := ret[0].(error)
@@ -1283,3 +2266,18 @@ func (_m *MockAutoScalingAPI) WaitUntilGroupNotExists(_param0 *autoscaling.Descr
 func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupNotExists(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupNotExists", arg0)
 }
+
+func (_m *MockAutoScalingAPI) WaitUntilGroupNotExistsWithContext(_param0 aws.Context, _param1 *autoscaling.DescribeAutoScalingGroupsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilGroupNotExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockAutoScalingAPIRecorder) WaitUntilGroupNotExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilGroupNotExistsWithContext", _s...)
+}
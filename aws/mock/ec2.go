@@ -4,6 +4,7 @@
 package mock
 
 import (
+	aws "github.com/aws/aws-sdk-go/aws"
 	request "github.com/aws/aws-sdk-go/aws/request"
 	ec2 "github.com/aws/aws-sdk-go/service/ec2"
 	gomock "github.com/golang/mock/gomock"
@@ -52,6 +53,22 @@ func (_mr *_MockEC2APIRecorder) AcceptReservedInstancesExchangeQuote(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AcceptReservedInstancesExchangeQuote", arg0)
 }
 
+func (_m *MockEC2API) AcceptReservedInstancesExchangeQuoteWithContext(_param0 aws.Context, _param1 *ec2.AcceptReservedInstancesExchangeQuoteInput, _param2 ...request.Option) (*ec2.AcceptReservedInstancesExchangeQuoteOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AcceptReservedInstancesExchangeQuoteWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AcceptReservedInstancesExchangeQuoteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AcceptReservedInstancesExchangeQuoteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AcceptReservedInstancesExchangeQuoteWithContext", _s...)
+}
+
 func (_m *MockEC2API) AcceptVpcPeeringConnectionRequest(_param0 *ec2.AcceptVpcPeeringConnectionInput) (*request.Request, *ec2.AcceptVpcPeeringConnectionOutput) {
 	ret := _m.ctrl.Call(_m, "AcceptVpcPeeringConnectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -74,6 +91,22 @@ func (_mr *_MockEC2APIRecorder) AcceptVpcPeeringConnection(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AcceptVpcPeeringConnection", arg0)
 }
 
+func (_m *MockEC2API) AcceptVpcPeeringConnectionWithContext(_param0 aws.Context, _param1 *ec2.AcceptVpcPeeringConnectionInput, _param2 ...request.Option) (*ec2.AcceptVpcPeeringConnectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AcceptVpcPeeringConnectionWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AcceptVpcPeeringConnectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AcceptVpcPeeringConnectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AcceptVpcPeeringConnectionWithContext", _s...)
+}
+
 func (_m *MockEC2API) AllocateAddressRequest(_param0 *ec2.AllocateAddressInput) (*request.Request, *ec2.AllocateAddressOutput) {
 	ret := _m.ctrl.Call(_m, "AllocateAddressRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -96,6 +129,22 @@ func (_mr *_MockEC2APIRecorder) AllocateAddress(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AllocateAddress", arg0)
 }
 
+func (_m *MockEC2API) AllocateAddressWithContext(_param0 aws.Context, _param1 *ec2.AllocateAddressInput, _param2 ...request.Option) (*ec2.AllocateAddressOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AllocateAddressWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AllocateAddressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AllocateAddressWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AllocateAddressWithContext", _s...)
+}
+
 func (_m *MockEC2API) AllocateHostsRequest(_param0 *ec2.AllocateHostsInput) (*request.Request, *ec2.AllocateHostsOutput) {
 	ret := _m.ctrl.Call(_m, "AllocateHostsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -118,6 +167,22 @@ func (_mr *_MockEC2APIRecorder) AllocateHosts(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AllocateHosts", arg0)
 }
 
+func (_m *MockEC2API) AllocateHostsWithContext(_param0 aws.Context, _param1 *ec2.AllocateHostsInput, _param2 ...request.Option) (*ec2.AllocateHostsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AllocateHostsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AllocateHostsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AllocateHostsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AllocateHostsWithContext", _s...)
+}
+
 func (_m *MockEC2API) AssignIpv6AddressesRequest(_param0 *ec2.AssignIpv6AddressesInput) (*request.Request, *ec2.AssignIpv6AddressesOutput) {
 	ret := _m.ctrl.Call(_m, "AssignIpv6AddressesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -140,6 +205,22 @@ func (_mr *_MockEC2APIRecorder) AssignIpv6Addresses(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssignIpv6Addresses", arg0)
 }
 
+func (_m *MockEC2API) AssignIpv6AddressesWithContext(_param0 aws.Context, _param1 *ec2.AssignIpv6AddressesInput, _param2 ...request.Option) (*ec2.AssignIpv6AddressesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AssignIpv6AddressesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AssignIpv6AddressesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AssignIpv6AddressesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssignIpv6AddressesWithContext", _s...)
+}
+
 func (_m *MockEC2API) AssignPrivateIpAddressesRequest(_param0 *ec2.AssignPrivateIpAddressesInput) (*request.Request, *ec2.AssignPrivateIpAddressesOutput) {
 	ret := _m.ctrl.Call(_m, "AssignPrivateIpAddressesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -162,6 +243,22 @@ func (_mr *_MockEC2APIRecorder) AssignPrivateIpAddresses(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssignPrivateIpAddresses", arg0)
 }
 
+func (_m *MockEC2API) AssignPrivateIpAddressesWithContext(_param0 aws.Context, _param1 *ec2.AssignPrivateIpAddressesInput, _param2 ...request.Option) (*ec2.AssignPrivateIpAddressesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AssignPrivateIpAddressesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AssignPrivateIpAddressesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AssignPrivateIpAddressesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssignPrivateIpAddressesWithContext", _s...)
+}
+
 func (_m *MockEC2API) AssociateAddressRequest(_param0 *ec2.AssociateAddressInput) (*request.Request, *ec2.AssociateAddressOutput) {
 	ret := _m.ctrl.Call(_m, "AssociateAddressRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -184,6 +281,22 @@ func (_mr *_MockEC2APIRecorder) AssociateAddress(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateAddress", arg0)
 }
 
+func (_m *MockEC2API) AssociateAddressWithContext(_param0 aws.Context, _param1 *ec2.AssociateAddressInput, _param2 ...request.Option) (*ec2.AssociateAddressOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AssociateAddressWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AssociateAddressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AssociateAddressWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateAddressWithContext", _s...)
+}
+
 func (_m *MockEC2API) AssociateDhcpOptionsRequest(_param0 *ec2.AssociateDhcpOptionsInput) (*request.Request, *ec2.AssociateDhcpOptionsOutput) {
 	ret := _m.ctrl.Call(_m, "AssociateDhcpOptionsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -206,6 +319,22 @@ func (_mr *_MockEC2APIRecorder) AssociateDhcpOptions(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateDhcpOptions", arg0)
 }
 
+func (_m *MockEC2API) AssociateDhcpOptionsWithContext(_param0 aws.Context, _param1 *ec2.AssociateDhcpOptionsInput, _param2 ...request.Option) (*ec2.AssociateDhcpOptionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AssociateDhcpOptionsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AssociateDhcpOptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AssociateDhcpOptionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateDhcpOptionsWithContext", _s...)
+}
+
 func (_m *MockEC2API) AssociateIamInstanceProfileRequest(_param0 *ec2.AssociateIamInstanceProfileInput) (*request.Request, *ec2.AssociateIamInstanceProfileOutput) {
 	ret := _m.ctrl.Call(_m, "AssociateIamInstanceProfileRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -228,6 +357,22 @@ func (_mr *_MockEC2APIRecorder) AssociateIamInstanceProfile(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateIamInstanceProfile", arg0)
 }
 
+func (_m *MockEC2API) AssociateIamInstanceProfileWithContext(_param0 aws.Context, _param1 *ec2.AssociateIamInstanceProfileInput, _param2 ...request.Option) (*ec2.AssociateIamInstanceProfileOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AssociateIamInstanceProfileWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AssociateIamInstanceProfileOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AssociateIamInstanceProfileWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateIamInstanceProfileWithContext", _s...)
+}
+
 func (_m *MockEC2API) AssociateRouteTableRequest(_param0 *ec2.AssociateRouteTableInput) (*request.Request, *ec2.AssociateRouteTableOutput) {
 	ret := _m.ctrl.Call(_m, "AssociateRouteTableRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -250,6 +395,22 @@ func (_mr *_MockEC2APIRecorder) AssociateRouteTable(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateRouteTable", arg0)
 }
 
+func (_m *MockEC2API) AssociateRouteTableWithContext(_param0 aws.Context, _param1 *ec2.AssociateRouteTableInput, _param2 ...request.Option) (*ec2.AssociateRouteTableOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AssociateRouteTableWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AssociateRouteTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AssociateRouteTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateRouteTableWithContext", _s...)
+}
+
 func (_m *MockEC2API) AssociateSubnetCidrBlockRequest(_param0 *ec2.AssociateSubnetCidrBlockInput) (*request.Request, *ec2.AssociateSubnetCidrBlockOutput) {
 	ret := _m.ctrl.Call(_m, "AssociateSubnetCidrBlockRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -272,6 +433,22 @@ func (_mr *_MockEC2APIRecorder) AssociateSubnetCidrBlock(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateSubnetCidrBlock", arg0)
 }
 
+func (_m *MockEC2API) AssociateSubnetCidrBlockWithContext(_param0 aws.Context, _param1 *ec2.AssociateSubnetCidrBlockInput, _param2 ...request.Option) (*ec2.AssociateSubnetCidrBlockOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AssociateSubnetCidrBlockWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AssociateSubnetCidrBlockOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AssociateSubnetCidrBlockWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateSubnetCidrBlockWithContext", _s...)
+}
+
 func (_m *MockEC2API) AssociateVpcCidrBlockRequest(_param0 *ec2.AssociateVpcCidrBlockInput) (*request.Request, *ec2.AssociateVpcCidrBlockOutput) {
 	ret := _m.ctrl.Call(_m, "AssociateVpcCidrBlockRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -294,6 +471,22 @@ func (_mr *_MockEC2APIRecorder) AssociateVpcCidrBlock(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateVpcCidrBlock", arg0)
 }
 
+func (_m *MockEC2API) AssociateVpcCidrBlockWithContext(_param0 aws.Context, _param1 *ec2.AssociateVpcCidrBlockInput, _param2 ...request.Option) (*ec2.AssociateVpcCidrBlockOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AssociateVpcCidrBlockWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AssociateVpcCidrBlockOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AssociateVpcCidrBlockWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AssociateVpcCidrBlockWithContext", _s...)
+}
+
 func (_m *MockEC2API) AttachClassicLinkVpcRequest(_param0 *ec2.AttachClassicLinkVpcInput) (*request.Request, *ec2.AttachClassicLinkVpcOutput) {
 	ret := _m.ctrl.Call(_m, "AttachClassicLinkVpcRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -316,6 +509,22 @@ func (_mr *_MockEC2APIRecorder) AttachClassicLinkVpc(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachClassicLinkVpc", arg0)
 }
 
+func (_m *MockEC2API) AttachClassicLinkVpcWithContext(_param0 aws.Context, _param1 *ec2.AttachClassicLinkVpcInput, _param2 ...request.Option) (*ec2.AttachClassicLinkVpcOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachClassicLinkVpcWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AttachClassicLinkVpcOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AttachClassicLinkVpcWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachClassicLinkVpcWithContext", _s...)
+}
+
 func (_m *MockEC2API) AttachInternetGatewayRequest(_param0 *ec2.AttachInternetGatewayInput) (*request.Request, *ec2.AttachInternetGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "AttachInternetGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -338,6 +547,22 @@ func (_mr *_MockEC2APIRecorder) AttachInternetGateway(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachInternetGateway", arg0)
 }
 
+func (_m *MockEC2API) AttachInternetGatewayWithContext(_param0 aws.Context, _param1 *ec2.AttachInternetGatewayInput, _param2 ...request.Option) (*ec2.AttachInternetGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachInternetGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AttachInternetGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AttachInternetGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachInternetGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) AttachNetworkInterfaceRequest(_param0 *ec2.AttachNetworkInterfaceInput) (*request.Request, *ec2.AttachNetworkInterfaceOutput) {
 	ret := _m.ctrl.Call(_m, "AttachNetworkInterfaceRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -360,6 +585,22 @@ func (_mr *_MockEC2APIRecorder) AttachNetworkInterface(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachNetworkInterface", arg0)
 }
 
+func (_m *MockEC2API) AttachNetworkInterfaceWithContext(_param0 aws.Context, _param1 *ec2.AttachNetworkInterfaceInput, _param2 ...request.Option) (*ec2.AttachNetworkInterfaceOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachNetworkInterfaceWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AttachNetworkInterfaceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AttachNetworkInterfaceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachNetworkInterfaceWithContext", _s...)
+}
+
 func (_m *MockEC2API) AttachVolumeRequest(_param0 *ec2.AttachVolumeInput) (*request.Request, *ec2.VolumeAttachment) {
 	ret := _m.ctrl.Call(_m, "AttachVolumeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -382,6 +623,22 @@ func (_mr *_MockEC2APIRecorder) AttachVolume(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachVolume", arg0)
 }
 
+func (_m *MockEC2API) AttachVolumeWithContext(_param0 aws.Context, _param1 *ec2.AttachVolumeInput, _param2 ...request.Option) (*ec2.VolumeAttachment, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachVolumeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.VolumeAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AttachVolumeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachVolumeWithContext", _s...)
+}
+
 func (_m *MockEC2API) AttachVpnGatewayRequest(_param0 *ec2.AttachVpnGatewayInput) (*request.Request, *ec2.AttachVpnGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "AttachVpnGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -404,6 +661,22 @@ func (_mr *_MockEC2APIRecorder) AttachVpnGateway(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachVpnGateway", arg0)
 }
 
+func (_m *MockEC2API) AttachVpnGatewayWithContext(_param0 aws.Context, _param1 *ec2.AttachVpnGatewayInput, _param2 ...request.Option) (*ec2.AttachVpnGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AttachVpnGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AttachVpnGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AttachVpnGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttachVpnGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) AuthorizeSecurityGroupEgressRequest(_param0 *ec2.AuthorizeSecurityGroupEgressInput) (*request.Request, *ec2.AuthorizeSecurityGroupEgressOutput) {
 	ret := _m.ctrl.Call(_m, "AuthorizeSecurityGroupEgressRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -426,6 +699,22 @@ func (_mr *_MockEC2APIRecorder) AuthorizeSecurityGroupEgress(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AuthorizeSecurityGroupEgress", arg0)
 }
 
+func (_m *MockEC2API) AuthorizeSecurityGroupEgressWithContext(_param0 aws.Context, _param1 *ec2.AuthorizeSecurityGroupEgressInput, _param2 ...request.Option) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AuthorizeSecurityGroupEgressWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AuthorizeSecurityGroupEgressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AuthorizeSecurityGroupEgressWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AuthorizeSecurityGroupEgressWithContext", _s...)
+}
+
 func (_m *MockEC2API) AuthorizeSecurityGroupIngressRequest(_param0 *ec2.AuthorizeSecurityGroupIngressInput) (*request.Request, *ec2.AuthorizeSecurityGroupIngressOutput) {
 	ret := _m.ctrl.Call(_m, "AuthorizeSecurityGroupIngressRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -448,6 +737,22 @@ func (_mr *_MockEC2APIRecorder) AuthorizeSecurityGroupIngress(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AuthorizeSecurityGroupIngress", arg0)
 }
 
+func (_m *MockEC2API) AuthorizeSecurityGroupIngressWithContext(_param0 aws.Context, _param1 *ec2.AuthorizeSecurityGroupIngressInput, _param2 ...request.Option) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AuthorizeSecurityGroupIngressWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.AuthorizeSecurityGroupIngressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) AuthorizeSecurityGroupIngressWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AuthorizeSecurityGroupIngressWithContext", _s...)
+}
+
 func (_m *MockEC2API) BundleInstanceRequest(_param0 *ec2.BundleInstanceInput) (*request.Request, *ec2.BundleInstanceOutput) {
 	ret := _m.ctrl.Call(_m, "BundleInstanceRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -470,6 +775,22 @@ func (_mr *_MockEC2APIRecorder) BundleInstance(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "BundleInstance", arg0)
 }
 
+func (_m *MockEC2API) BundleInstanceWithContext(_param0 aws.Context, _param1 *ec2.BundleInstanceInput, _param2 ...request.Option) (*ec2.BundleInstanceOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "BundleInstanceWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.BundleInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) BundleInstanceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BundleInstanceWithContext", _s...)
+}
+
 func (_m *MockEC2API) CancelBundleTaskRequest(_param0 *ec2.CancelBundleTaskInput) (*request.Request, *ec2.CancelBundleTaskOutput) {
 	ret := _m.ctrl.Call(_m, "CancelBundleTaskRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -492,6 +813,22 @@ func (_mr *_MockEC2APIRecorder) CancelBundleTask(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelBundleTask", arg0)
 }
 
+func (_m *MockEC2API) CancelBundleTaskWithContext(_param0 aws.Context, _param1 *ec2.CancelBundleTaskInput, _param2 ...request.Option) (*ec2.CancelBundleTaskOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CancelBundleTaskWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CancelBundleTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CancelBundleTaskWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelBundleTaskWithContext", _s...)
+}
+
 func (_m *MockEC2API) CancelConversionTaskRequest(_param0 *ec2.CancelConversionTaskInput) (*request.Request, *ec2.CancelConversionTaskOutput) {
 	ret := _m.ctrl.Call(_m, "CancelConversionTaskRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -514,6 +851,22 @@ func (_mr *_MockEC2APIRecorder) CancelConversionTask(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelConversionTask", arg0)
 }
 
+func (_m *MockEC2API) CancelConversionTaskWithContext(_param0 aws.Context, _param1 *ec2.CancelConversionTaskInput, _param2 ...request.Option) (*ec2.CancelConversionTaskOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CancelConversionTaskWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CancelConversionTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CancelConversionTaskWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelConversionTaskWithContext", _s...)
+}
+
 func (_m *MockEC2API) CancelExportTaskRequest(_param0 *ec2.CancelExportTaskInput) (*request.Request, *ec2.CancelExportTaskOutput) {
 	ret := _m.ctrl.Call(_m, "CancelExportTaskRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -536,6 +889,22 @@ func (_mr *_MockEC2APIRecorder) CancelExportTask(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelExportTask", arg0)
 }
 
+func (_m *MockEC2API) CancelExportTaskWithContext(_param0 aws.Context, _param1 *ec2.CancelExportTaskInput, _param2 ...request.Option) (*ec2.CancelExportTaskOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CancelExportTaskWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CancelExportTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CancelExportTaskWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelExportTaskWithContext", _s...)
+}
+
 func (_m *MockEC2API) CancelImportTaskRequest(_param0 *ec2.CancelImportTaskInput) (*request.Request, *ec2.CancelImportTaskOutput) {
 	ret := _m.ctrl.Call(_m, "CancelImportTaskRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -558,6 +927,22 @@ func (_mr *_MockEC2APIRecorder) CancelImportTask(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelImportTask", arg0)
 }
 
+func (_m *MockEC2API) CancelImportTaskWithContext(_param0 aws.Context, _param1 *ec2.CancelImportTaskInput, _param2 ...request.Option) (*ec2.CancelImportTaskOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CancelImportTaskWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CancelImportTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CancelImportTaskWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelImportTaskWithContext", _s...)
+}
+
 func (_m *MockEC2API) CancelReservedInstancesListingRequest(_param0 *ec2.CancelReservedInstancesListingInput) (*request.Request, *ec2.CancelReservedInstancesListingOutput) {
 	ret := _m.ctrl.Call(_m, "CancelReservedInstancesListingRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -580,6 +965,22 @@ func (_mr *_MockEC2APIRecorder) CancelReservedInstancesListing(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelReservedInstancesListing", arg0)
 }
 
+func (_m *MockEC2API) CancelReservedInstancesListingWithContext(_param0 aws.Context, _param1 *ec2.CancelReservedInstancesListingInput, _param2 ...request.Option) (*ec2.CancelReservedInstancesListingOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CancelReservedInstancesListingWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CancelReservedInstancesListingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CancelReservedInstancesListingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelReservedInstancesListingWithContext", _s...)
+}
+
 func (_m *MockEC2API) CancelSpotFleetRequestsRequest(_param0 *ec2.CancelSpotFleetRequestsInput) (*request.Request, *ec2.CancelSpotFleetRequestsOutput) {
 	ret := _m.ctrl.Call(_m, "CancelSpotFleetRequestsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -602,6 +1003,22 @@ func (_mr *_MockEC2APIRecorder) CancelSpotFleetRequests(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelSpotFleetRequests", arg0)
 }
 
+func (_m *MockEC2API) CancelSpotFleetRequestsWithContext(_param0 aws.Context, _param1 *ec2.CancelSpotFleetRequestsInput, _param2 ...request.Option) (*ec2.CancelSpotFleetRequestsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CancelSpotFleetRequestsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CancelSpotFleetRequestsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CancelSpotFleetRequestsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelSpotFleetRequestsWithContext", _s...)
+}
+
 func (_m *MockEC2API) CancelSpotInstanceRequestsRequest(_param0 *ec2.CancelSpotInstanceRequestsInput) (*request.Request, *ec2.CancelSpotInstanceRequestsOutput) {
 	ret := _m.ctrl.Call(_m, "CancelSpotInstanceRequestsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -624,6 +1041,22 @@ func (_mr *_MockEC2APIRecorder) CancelSpotInstanceRequests(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelSpotInstanceRequests", arg0)
 }
 
+func (_m *MockEC2API) CancelSpotInstanceRequestsWithContext(_param0 aws.Context, _param1 *ec2.CancelSpotInstanceRequestsInput, _param2 ...request.Option) (*ec2.CancelSpotInstanceRequestsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CancelSpotInstanceRequestsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CancelSpotInstanceRequestsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CancelSpotInstanceRequestsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CancelSpotInstanceRequestsWithContext", _s...)
+}
+
 func (_m *MockEC2API) ConfirmProductInstanceRequest(_param0 *ec2.ConfirmProductInstanceInput) (*request.Request, *ec2.ConfirmProductInstanceOutput) {
 	ret := _m.ctrl.Call(_m, "ConfirmProductInstanceRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -646,6 +1079,22 @@ func (_mr *_MockEC2APIRecorder) ConfirmProductInstance(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ConfirmProductInstance", arg0)
 }
 
+func (_m *MockEC2API) ConfirmProductInstanceWithContext(_param0 aws.Context, _param1 *ec2.ConfirmProductInstanceInput, _param2 ...request.Option) (*ec2.ConfirmProductInstanceOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ConfirmProductInstanceWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ConfirmProductInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ConfirmProductInstanceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ConfirmProductInstanceWithContext", _s...)
+}
+
 func (_m *MockEC2API) CopyImageRequest(_param0 *ec2.CopyImageInput) (*request.Request, *ec2.CopyImageOutput) {
 	ret := _m.ctrl.Call(_m, "CopyImageRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -668,6 +1117,22 @@ func (_mr *_MockEC2APIRecorder) CopyImage(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CopyImage", arg0)
 }
 
+func (_m *MockEC2API) CopyImageWithContext(_param0 aws.Context, _param1 *ec2.CopyImageInput, _param2 ...request.Option) (*ec2.CopyImageOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CopyImageWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CopyImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CopyImageWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CopyImageWithContext", _s...)
+}
+
 func (_m *MockEC2API) CopySnapshotRequest(_param0 *ec2.CopySnapshotInput) (*request.Request, *ec2.CopySnapshotOutput) {
 	ret := _m.ctrl.Call(_m, "CopySnapshotRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -690,6 +1155,22 @@ func (_mr *_MockEC2APIRecorder) CopySnapshot(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CopySnapshot", arg0)
 }
 
+func (_m *MockEC2API) CopySnapshotWithContext(_param0 aws.Context, _param1 *ec2.CopySnapshotInput, _param2 ...request.Option) (*ec2.CopySnapshotOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CopySnapshotWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CopySnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CopySnapshotWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CopySnapshotWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateCustomerGatewayRequest(_param0 *ec2.CreateCustomerGatewayInput) (*request.Request, *ec2.CreateCustomerGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "CreateCustomerGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -712,6 +1193,22 @@ func (_mr *_MockEC2APIRecorder) CreateCustomerGateway(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateCustomerGateway", arg0)
 }
 
+func (_m *MockEC2API) CreateCustomerGatewayWithContext(_param0 aws.Context, _param1 *ec2.CreateCustomerGatewayInput, _param2 ...request.Option) (*ec2.CreateCustomerGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateCustomerGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateCustomerGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateCustomerGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateCustomerGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateDhcpOptionsRequest(_param0 *ec2.CreateDhcpOptionsInput) (*request.Request, *ec2.CreateDhcpOptionsOutput) {
 	ret := _m.ctrl.Call(_m, "CreateDhcpOptionsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -734,6 +1231,22 @@ func (_mr *_MockEC2APIRecorder) CreateDhcpOptions(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateDhcpOptions", arg0)
 }
 
+func (_m *MockEC2API) CreateDhcpOptionsWithContext(_param0 aws.Context, _param1 *ec2.CreateDhcpOptionsInput, _param2 ...request.Option) (*ec2.CreateDhcpOptionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateDhcpOptionsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateDhcpOptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateDhcpOptionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateDhcpOptionsWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateEgressOnlyInternetGatewayRequest(_param0 *ec2.CreateEgressOnlyInternetGatewayInput) (*request.Request, *ec2.CreateEgressOnlyInternetGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "CreateEgressOnlyInternetGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -756,6 +1269,22 @@ func (_mr *_MockEC2APIRecorder) CreateEgressOnlyInternetGateway(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateEgressOnlyInternetGateway", arg0)
 }
 
+func (_m *MockEC2API) CreateEgressOnlyInternetGatewayWithContext(_param0 aws.Context, _param1 *ec2.CreateEgressOnlyInternetGatewayInput, _param2 ...request.Option) (*ec2.CreateEgressOnlyInternetGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateEgressOnlyInternetGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateEgressOnlyInternetGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateEgressOnlyInternetGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateEgressOnlyInternetGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateFlowLogsRequest(_param0 *ec2.CreateFlowLogsInput) (*request.Request, *ec2.CreateFlowLogsOutput) {
 	ret := _m.ctrl.Call(_m, "CreateFlowLogsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -778,6 +1307,22 @@ func (_mr *_MockEC2APIRecorder) CreateFlowLogs(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateFlowLogs", arg0)
 }
 
+func (_m *MockEC2API) CreateFlowLogsWithContext(_param0 aws.Context, _param1 *ec2.CreateFlowLogsInput, _param2 ...request.Option) (*ec2.CreateFlowLogsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateFlowLogsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateFlowLogsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateFlowLogsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateFlowLogsWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateImageRequest(_param0 *ec2.CreateImageInput) (*request.Request, *ec2.CreateImageOutput) {
 	ret := _m.ctrl.Call(_m, "CreateImageRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -800,6 +1345,22 @@ func (_mr *_MockEC2APIRecorder) CreateImage(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateImage", arg0)
 }
 
+func (_m *MockEC2API) CreateImageWithContext(_param0 aws.Context, _param1 *ec2.CreateImageInput, _param2 ...request.Option) (*ec2.CreateImageOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateImageWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateImageWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateImageWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateInstanceExportTaskRequest(_param0 *ec2.CreateInstanceExportTaskInput) (*request.Request, *ec2.CreateInstanceExportTaskOutput) {
 	ret := _m.ctrl.Call(_m, "CreateInstanceExportTaskRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -822,6 +1383,22 @@ func (_mr *_MockEC2APIRecorder) CreateInstanceExportTask(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateInstanceExportTask", arg0)
 }
 
+func (_m *MockEC2API) CreateInstanceExportTaskWithContext(_param0 aws.Context, _param1 *ec2.CreateInstanceExportTaskInput, _param2 ...request.Option) (*ec2.CreateInstanceExportTaskOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateInstanceExportTaskWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateInstanceExportTaskOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateInstanceExportTaskWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateInstanceExportTaskWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateInternetGatewayRequest(_param0 *ec2.CreateInternetGatewayInput) (*request.Request, *ec2.CreateInternetGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "CreateInternetGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -844,10 +1421,26 @@ func (_mr *_MockEC2APIRecorder) CreateInternetGateway(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateInternetGateway", arg0)
 }
 
-func (_m *MockEC2API) CreateKeyPairRequest(_param0 *ec2.CreateKeyPairInput) (*request.Request, *ec2.CreateKeyPairOutput) {
-	ret := _m.ctrl.Call(_m, "CreateKeyPairRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*ec2.CreateKeyPairOutput)
+func (_m *MockEC2API) CreateInternetGatewayWithContext(_param0 aws.Context, _param1 *ec2.CreateInternetGatewayInput, _param2 ...request.Option) (*ec2.CreateInternetGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateInternetGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateInternetGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateInternetGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateInternetGatewayWithContext", _s...)
+}
+
+func (_m *MockEC2API) CreateKeyPairRequest(_param0 *ec2.CreateKeyPairInput) (*request.Request, *ec2.CreateKeyPairOutput) {
+	ret := _m.ctrl.Call(_m, "CreateKeyPairRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ec2.CreateKeyPairOutput)
 	return ret0, ret1
 }
 
@@ -866,6 +1459,22 @@ func (_mr *_MockEC2APIRecorder) CreateKeyPair(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateKeyPair", arg0)
 }
 
+func (_m *MockEC2API) CreateKeyPairWithContext(_param0 aws.Context, _param1 *ec2.CreateKeyPairInput, _param2 ...request.Option) (*ec2.CreateKeyPairOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateKeyPairWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateKeyPairOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateKeyPairWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateKeyPairWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateNatGatewayRequest(_param0 *ec2.CreateNatGatewayInput) (*request.Request, *ec2.CreateNatGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "CreateNatGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -888,6 +1497,22 @@ func (_mr *_MockEC2APIRecorder) CreateNatGateway(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateNatGateway", arg0)
 }
 
+func (_m *MockEC2API) CreateNatGatewayWithContext(_param0 aws.Context, _param1 *ec2.CreateNatGatewayInput, _param2 ...request.Option) (*ec2.CreateNatGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateNatGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateNatGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateNatGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateNatGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateNetworkAclRequest(_param0 *ec2.CreateNetworkAclInput) (*request.Request, *ec2.CreateNetworkAclOutput) {
 	ret := _m.ctrl.Call(_m, "CreateNetworkAclRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -910,6 +1535,22 @@ func (_mr *_MockEC2APIRecorder) CreateNetworkAcl(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateNetworkAcl", arg0)
 }
 
+func (_m *MockEC2API) CreateNetworkAclWithContext(_param0 aws.Context, _param1 *ec2.CreateNetworkAclInput, _param2 ...request.Option) (*ec2.CreateNetworkAclOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateNetworkAclWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateNetworkAclOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateNetworkAclWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateNetworkAclWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateNetworkAclEntryRequest(_param0 *ec2.CreateNetworkAclEntryInput) (*request.Request, *ec2.CreateNetworkAclEntryOutput) {
 	ret := _m.ctrl.Call(_m, "CreateNetworkAclEntryRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -932,6 +1573,22 @@ func (_mr *_MockEC2APIRecorder) CreateNetworkAclEntry(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateNetworkAclEntry", arg0)
 }
 
+func (_m *MockEC2API) CreateNetworkAclEntryWithContext(_param0 aws.Context, _param1 *ec2.CreateNetworkAclEntryInput, _param2 ...request.Option) (*ec2.CreateNetworkAclEntryOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateNetworkAclEntryWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateNetworkAclEntryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateNetworkAclEntryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateNetworkAclEntryWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateNetworkInterfaceRequest(_param0 *ec2.CreateNetworkInterfaceInput) (*request.Request, *ec2.CreateNetworkInterfaceOutput) {
 	ret := _m.ctrl.Call(_m, "CreateNetworkInterfaceRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -954,6 +1611,22 @@ func (_mr *_MockEC2APIRecorder) CreateNetworkInterface(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateNetworkInterface", arg0)
 }
 
+func (_m *MockEC2API) CreateNetworkInterfaceWithContext(_param0 aws.Context, _param1 *ec2.CreateNetworkInterfaceInput, _param2 ...request.Option) (*ec2.CreateNetworkInterfaceOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateNetworkInterfaceWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateNetworkInterfaceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateNetworkInterfaceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateNetworkInterfaceWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreatePlacementGroupRequest(_param0 *ec2.CreatePlacementGroupInput) (*request.Request, *ec2.CreatePlacementGroupOutput) {
 	ret := _m.ctrl.Call(_m, "CreatePlacementGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -976,6 +1649,22 @@ func (_mr *_MockEC2APIRecorder) CreatePlacementGroup(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreatePlacementGroup", arg0)
 }
 
+func (_m *MockEC2API) CreatePlacementGroupWithContext(_param0 aws.Context, _param1 *ec2.CreatePlacementGroupInput, _param2 ...request.Option) (*ec2.CreatePlacementGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreatePlacementGroupWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreatePlacementGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreatePlacementGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreatePlacementGroupWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateReservedInstancesListingRequest(_param0 *ec2.CreateReservedInstancesListingInput) (*request.Request, *ec2.CreateReservedInstancesListingOutput) {
 	ret := _m.ctrl.Call(_m, "CreateReservedInstancesListingRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -998,6 +1687,22 @@ func (_mr *_MockEC2APIRecorder) CreateReservedInstancesListing(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateReservedInstancesListing", arg0)
 }
 
+func (_m *MockEC2API) CreateReservedInstancesListingWithContext(_param0 aws.Context, _param1 *ec2.CreateReservedInstancesListingInput, _param2 ...request.Option) (*ec2.CreateReservedInstancesListingOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateReservedInstancesListingWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateReservedInstancesListingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateReservedInstancesListingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateReservedInstancesListingWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateRouteRequest(_param0 *ec2.CreateRouteInput) (*request.Request, *ec2.CreateRouteOutput) {
 	ret := _m.ctrl.Call(_m, "CreateRouteRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1020,6 +1725,22 @@ func (_mr *_MockEC2APIRecorder) CreateRoute(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateRoute", arg0)
 }
 
+func (_m *MockEC2API) CreateRouteWithContext(_param0 aws.Context, _param1 *ec2.CreateRouteInput, _param2 ...request.Option) (*ec2.CreateRouteOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateRouteWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateRouteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateRouteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateRouteWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateRouteTableRequest(_param0 *ec2.CreateRouteTableInput) (*request.Request, *ec2.CreateRouteTableOutput) {
 	ret := _m.ctrl.Call(_m, "CreateRouteTableRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1042,6 +1763,22 @@ func (_mr *_MockEC2APIRecorder) CreateRouteTable(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateRouteTable", arg0)
 }
 
+func (_m *MockEC2API) CreateRouteTableWithContext(_param0 aws.Context, _param1 *ec2.CreateRouteTableInput, _param2 ...request.Option) (*ec2.CreateRouteTableOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateRouteTableWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateRouteTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateRouteTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateRouteTableWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateSecurityGroupRequest(_param0 *ec2.CreateSecurityGroupInput) (*request.Request, *ec2.CreateSecurityGroupOutput) {
 	ret := _m.ctrl.Call(_m, "CreateSecurityGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1064,6 +1801,22 @@ func (_mr *_MockEC2APIRecorder) CreateSecurityGroup(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateSecurityGroup", arg0)
 }
 
+func (_m *MockEC2API) CreateSecurityGroupWithContext(_param0 aws.Context, _param1 *ec2.CreateSecurityGroupInput, _param2 ...request.Option) (*ec2.CreateSecurityGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateSecurityGroupWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateSecurityGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateSecurityGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateSecurityGroupWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateSnapshotRequest(_param0 *ec2.CreateSnapshotInput) (*request.Request, *ec2.Snapshot) {
 	ret := _m.ctrl.Call(_m, "CreateSnapshotRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1086,6 +1839,22 @@ func (_mr *_MockEC2APIRecorder) CreateSnapshot(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateSnapshot", arg0)
 }
 
+func (_m *MockEC2API) CreateSnapshotWithContext(_param0 aws.Context, _param1 *ec2.CreateSnapshotInput, _param2 ...request.Option) (*ec2.Snapshot, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateSnapshotWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateSnapshotWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateSnapshotWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateSpotDatafeedSubscriptionRequest(_param0 *ec2.CreateSpotDatafeedSubscriptionInput) (*request.Request, *ec2.CreateSpotDatafeedSubscriptionOutput) {
 	ret := _m.ctrl.Call(_m, "CreateSpotDatafeedSubscriptionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1108,6 +1877,22 @@ func (_mr *_MockEC2APIRecorder) CreateSpotDatafeedSubscription(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateSpotDatafeedSubscription", arg0)
 }
 
+func (_m *MockEC2API) CreateSpotDatafeedSubscriptionWithContext(_param0 aws.Context, _param1 *ec2.CreateSpotDatafeedSubscriptionInput, _param2 ...request.Option) (*ec2.CreateSpotDatafeedSubscriptionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateSpotDatafeedSubscriptionWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateSpotDatafeedSubscriptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateSpotDatafeedSubscriptionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateSpotDatafeedSubscriptionWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateSubnetRequest(_param0 *ec2.CreateSubnetInput) (*request.Request, *ec2.CreateSubnetOutput) {
 	ret := _m.ctrl.Call(_m, "CreateSubnetRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1130,6 +1915,22 @@ func (_mr *_MockEC2APIRecorder) CreateSubnet(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateSubnet", arg0)
 }
 
+func (_m *MockEC2API) CreateSubnetWithContext(_param0 aws.Context, _param1 *ec2.CreateSubnetInput, _param2 ...request.Option) (*ec2.CreateSubnetOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateSubnetWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateSubnetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateSubnetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateSubnetWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateTagsRequest(_param0 *ec2.CreateTagsInput) (*request.Request, *ec2.CreateTagsOutput) {
 	ret := _m.ctrl.Call(_m, "CreateTagsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1152,6 +1953,22 @@ func (_mr *_MockEC2APIRecorder) CreateTags(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateTags", arg0)
 }
 
+func (_m *MockEC2API) CreateTagsWithContext(_param0 aws.Context, _param1 *ec2.CreateTagsInput, _param2 ...request.Option) (*ec2.CreateTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateTagsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateTagsWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateVolumeRequest(_param0 *ec2.CreateVolumeInput) (*request.Request, *ec2.Volume) {
 	ret := _m.ctrl.Call(_m, "CreateVolumeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1174,6 +1991,22 @@ func (_mr *_MockEC2APIRecorder) CreateVolume(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVolume", arg0)
 }
 
+func (_m *MockEC2API) CreateVolumeWithContext(_param0 aws.Context, _param1 *ec2.CreateVolumeInput, _param2 ...request.Option) (*ec2.Volume, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateVolumeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateVolumeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVolumeWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateVpcRequest(_param0 *ec2.CreateVpcInput) (*request.Request, *ec2.CreateVpcOutput) {
 	ret := _m.ctrl.Call(_m, "CreateVpcRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1196,6 +2029,22 @@ func (_mr *_MockEC2APIRecorder) CreateVpc(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpc", arg0)
 }
 
+func (_m *MockEC2API) CreateVpcWithContext(_param0 aws.Context, _param1 *ec2.CreateVpcInput, _param2 ...request.Option) (*ec2.CreateVpcOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateVpcWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateVpcOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateVpcWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpcWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateVpcEndpointRequest(_param0 *ec2.CreateVpcEndpointInput) (*request.Request, *ec2.CreateVpcEndpointOutput) {
 	ret := _m.ctrl.Call(_m, "CreateVpcEndpointRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1218,6 +2067,22 @@ func (_mr *_MockEC2APIRecorder) CreateVpcEndpoint(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpcEndpoint", arg0)
 }
 
+func (_m *MockEC2API) CreateVpcEndpointWithContext(_param0 aws.Context, _param1 *ec2.CreateVpcEndpointInput, _param2 ...request.Option) (*ec2.CreateVpcEndpointOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateVpcEndpointWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateVpcEndpointOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateVpcEndpointWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpcEndpointWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateVpcPeeringConnectionRequest(_param0 *ec2.CreateVpcPeeringConnectionInput) (*request.Request, *ec2.CreateVpcPeeringConnectionOutput) {
 	ret := _m.ctrl.Call(_m, "CreateVpcPeeringConnectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1240,6 +2105,22 @@ func (_mr *_MockEC2APIRecorder) CreateVpcPeeringConnection(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpcPeeringConnection", arg0)
 }
 
+func (_m *MockEC2API) CreateVpcPeeringConnectionWithContext(_param0 aws.Context, _param1 *ec2.CreateVpcPeeringConnectionInput, _param2 ...request.Option) (*ec2.CreateVpcPeeringConnectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateVpcPeeringConnectionWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateVpcPeeringConnectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateVpcPeeringConnectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpcPeeringConnectionWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateVpnConnectionRequest(_param0 *ec2.CreateVpnConnectionInput) (*request.Request, *ec2.CreateVpnConnectionOutput) {
 	ret := _m.ctrl.Call(_m, "CreateVpnConnectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1262,6 +2143,22 @@ func (_mr *_MockEC2APIRecorder) CreateVpnConnection(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpnConnection", arg0)
 }
 
+func (_m *MockEC2API) CreateVpnConnectionWithContext(_param0 aws.Context, _param1 *ec2.CreateVpnConnectionInput, _param2 ...request.Option) (*ec2.CreateVpnConnectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateVpnConnectionWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateVpnConnectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateVpnConnectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpnConnectionWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateVpnConnectionRouteRequest(_param0 *ec2.CreateVpnConnectionRouteInput) (*request.Request, *ec2.CreateVpnConnectionRouteOutput) {
 	ret := _m.ctrl.Call(_m, "CreateVpnConnectionRouteRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1284,6 +2181,22 @@ func (_mr *_MockEC2APIRecorder) CreateVpnConnectionRoute(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpnConnectionRoute", arg0)
 }
 
+func (_m *MockEC2API) CreateVpnConnectionRouteWithContext(_param0 aws.Context, _param1 *ec2.CreateVpnConnectionRouteInput, _param2 ...request.Option) (*ec2.CreateVpnConnectionRouteOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateVpnConnectionRouteWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateVpnConnectionRouteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateVpnConnectionRouteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpnConnectionRouteWithContext", _s...)
+}
+
 func (_m *MockEC2API) CreateVpnGatewayRequest(_param0 *ec2.CreateVpnGatewayInput) (*request.Request, *ec2.CreateVpnGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "CreateVpnGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1306,6 +2219,22 @@ func (_mr *_MockEC2APIRecorder) CreateVpnGateway(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpnGateway", arg0)
 }
 
+func (_m *MockEC2API) CreateVpnGatewayWithContext(_param0 aws.Context, _param1 *ec2.CreateVpnGatewayInput, _param2 ...request.Option) (*ec2.CreateVpnGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateVpnGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.CreateVpnGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) CreateVpnGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateVpnGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteCustomerGatewayRequest(_param0 *ec2.DeleteCustomerGatewayInput) (*request.Request, *ec2.DeleteCustomerGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteCustomerGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1328,6 +2257,22 @@ func (_mr *_MockEC2APIRecorder) DeleteCustomerGateway(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteCustomerGateway", arg0)
 }
 
+func (_m *MockEC2API) DeleteCustomerGatewayWithContext(_param0 aws.Context, _param1 *ec2.DeleteCustomerGatewayInput, _param2 ...request.Option) (*ec2.DeleteCustomerGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteCustomerGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteCustomerGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteCustomerGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteCustomerGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteDhcpOptionsRequest(_param0 *ec2.DeleteDhcpOptionsInput) (*request.Request, *ec2.DeleteDhcpOptionsOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteDhcpOptionsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1350,6 +2295,22 @@ func (_mr *_MockEC2APIRecorder) DeleteDhcpOptions(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteDhcpOptions", arg0)
 }
 
+func (_m *MockEC2API) DeleteDhcpOptionsWithContext(_param0 aws.Context, _param1 *ec2.DeleteDhcpOptionsInput, _param2 ...request.Option) (*ec2.DeleteDhcpOptionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteDhcpOptionsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteDhcpOptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteDhcpOptionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteDhcpOptionsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteEgressOnlyInternetGatewayRequest(_param0 *ec2.DeleteEgressOnlyInternetGatewayInput) (*request.Request, *ec2.DeleteEgressOnlyInternetGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteEgressOnlyInternetGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1372,6 +2333,22 @@ func (_mr *_MockEC2APIRecorder) DeleteEgressOnlyInternetGateway(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteEgressOnlyInternetGateway", arg0)
 }
 
+func (_m *MockEC2API) DeleteEgressOnlyInternetGatewayWithContext(_param0 aws.Context, _param1 *ec2.DeleteEgressOnlyInternetGatewayInput, _param2 ...request.Option) (*ec2.DeleteEgressOnlyInternetGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteEgressOnlyInternetGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteEgressOnlyInternetGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteEgressOnlyInternetGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteEgressOnlyInternetGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteFlowLogsRequest(_param0 *ec2.DeleteFlowLogsInput) (*request.Request, *ec2.DeleteFlowLogsOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteFlowLogsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1394,6 +2371,22 @@ func (_mr *_MockEC2APIRecorder) DeleteFlowLogs(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteFlowLogs", arg0)
 }
 
+func (_m *MockEC2API) DeleteFlowLogsWithContext(_param0 aws.Context, _param1 *ec2.DeleteFlowLogsInput, _param2 ...request.Option) (*ec2.DeleteFlowLogsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteFlowLogsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteFlowLogsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteFlowLogsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteFlowLogsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteInternetGatewayRequest(_param0 *ec2.DeleteInternetGatewayInput) (*request.Request, *ec2.DeleteInternetGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteInternetGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1416,6 +2409,22 @@ func (_mr *_MockEC2APIRecorder) DeleteInternetGateway(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteInternetGateway", arg0)
 }
 
+func (_m *MockEC2API) DeleteInternetGatewayWithContext(_param0 aws.Context, _param1 *ec2.DeleteInternetGatewayInput, _param2 ...request.Option) (*ec2.DeleteInternetGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteInternetGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteInternetGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteInternetGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteInternetGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteKeyPairRequest(_param0 *ec2.DeleteKeyPairInput) (*request.Request, *ec2.DeleteKeyPairOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteKeyPairRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1438,6 +2447,22 @@ func (_mr *_MockEC2APIRecorder) DeleteKeyPair(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteKeyPair", arg0)
 }
 
+func (_m *MockEC2API) DeleteKeyPairWithContext(_param0 aws.Context, _param1 *ec2.DeleteKeyPairInput, _param2 ...request.Option) (*ec2.DeleteKeyPairOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteKeyPairWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteKeyPairOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteKeyPairWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteKeyPairWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteNatGatewayRequest(_param0 *ec2.DeleteNatGatewayInput) (*request.Request, *ec2.DeleteNatGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteNatGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1460,6 +2485,22 @@ func (_mr *_MockEC2APIRecorder) DeleteNatGateway(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNatGateway", arg0)
 }
 
+func (_m *MockEC2API) DeleteNatGatewayWithContext(_param0 aws.Context, _param1 *ec2.DeleteNatGatewayInput, _param2 ...request.Option) (*ec2.DeleteNatGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteNatGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteNatGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteNatGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNatGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteNetworkAclRequest(_param0 *ec2.DeleteNetworkAclInput) (*request.Request, *ec2.DeleteNetworkAclOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteNetworkAclRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1482,6 +2523,22 @@ func (_mr *_MockEC2APIRecorder) DeleteNetworkAcl(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNetworkAcl", arg0)
 }
 
+func (_m *MockEC2API) DeleteNetworkAclWithContext(_param0 aws.Context, _param1 *ec2.DeleteNetworkAclInput, _param2 ...request.Option) (*ec2.DeleteNetworkAclOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteNetworkAclWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteNetworkAclOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteNetworkAclWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNetworkAclWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteNetworkAclEntryRequest(_param0 *ec2.DeleteNetworkAclEntryInput) (*request.Request, *ec2.DeleteNetworkAclEntryOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteNetworkAclEntryRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1504,6 +2561,22 @@ func (_mr *_MockEC2APIRecorder) DeleteNetworkAclEntry(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNetworkAclEntry", arg0)
 }
 
+func (_m *MockEC2API) DeleteNetworkAclEntryWithContext(_param0 aws.Context, _param1 *ec2.DeleteNetworkAclEntryInput, _param2 ...request.Option) (*ec2.DeleteNetworkAclEntryOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteNetworkAclEntryWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteNetworkAclEntryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteNetworkAclEntryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNetworkAclEntryWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteNetworkInterfaceRequest(_param0 *ec2.DeleteNetworkInterfaceInput) (*request.Request, *ec2.DeleteNetworkInterfaceOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteNetworkInterfaceRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1526,6 +2599,22 @@ func (_mr *_MockEC2APIRecorder) DeleteNetworkInterface(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNetworkInterface", arg0)
 }
 
+func (_m *MockEC2API) DeleteNetworkInterfaceWithContext(_param0 aws.Context, _param1 *ec2.DeleteNetworkInterfaceInput, _param2 ...request.Option) (*ec2.DeleteNetworkInterfaceOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteNetworkInterfaceWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteNetworkInterfaceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteNetworkInterfaceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteNetworkInterfaceWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeletePlacementGroupRequest(_param0 *ec2.DeletePlacementGroupInput) (*request.Request, *ec2.DeletePlacementGroupOutput) {
 	ret := _m.ctrl.Call(_m, "DeletePlacementGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1548,6 +2637,22 @@ func (_mr *_MockEC2APIRecorder) DeletePlacementGroup(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePlacementGroup", arg0)
 }
 
+func (_m *MockEC2API) DeletePlacementGroupWithContext(_param0 aws.Context, _param1 *ec2.DeletePlacementGroupInput, _param2 ...request.Option) (*ec2.DeletePlacementGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeletePlacementGroupWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeletePlacementGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeletePlacementGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeletePlacementGroupWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteRouteRequest(_param0 *ec2.DeleteRouteInput) (*request.Request, *ec2.DeleteRouteOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteRouteRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1570,6 +2675,22 @@ func (_mr *_MockEC2APIRecorder) DeleteRoute(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteRoute", arg0)
 }
 
+func (_m *MockEC2API) DeleteRouteWithContext(_param0 aws.Context, _param1 *ec2.DeleteRouteInput, _param2 ...request.Option) (*ec2.DeleteRouteOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteRouteWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteRouteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteRouteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteRouteWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteRouteTableRequest(_param0 *ec2.DeleteRouteTableInput) (*request.Request, *ec2.DeleteRouteTableOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteRouteTableRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1592,6 +2713,22 @@ func (_mr *_MockEC2APIRecorder) DeleteRouteTable(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteRouteTable", arg0)
 }
 
+func (_m *MockEC2API) DeleteRouteTableWithContext(_param0 aws.Context, _param1 *ec2.DeleteRouteTableInput, _param2 ...request.Option) (*ec2.DeleteRouteTableOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteRouteTableWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteRouteTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteRouteTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteRouteTableWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteSecurityGroupRequest(_param0 *ec2.DeleteSecurityGroupInput) (*request.Request, *ec2.DeleteSecurityGroupOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteSecurityGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1614,6 +2751,22 @@ func (_mr *_MockEC2APIRecorder) DeleteSecurityGroup(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteSecurityGroup", arg0)
 }
 
+func (_m *MockEC2API) DeleteSecurityGroupWithContext(_param0 aws.Context, _param1 *ec2.DeleteSecurityGroupInput, _param2 ...request.Option) (*ec2.DeleteSecurityGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteSecurityGroupWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteSecurityGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteSecurityGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteSecurityGroupWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteSnapshotRequest(_param0 *ec2.DeleteSnapshotInput) (*request.Request, *ec2.DeleteSnapshotOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteSnapshotRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1636,6 +2789,22 @@ func (_mr *_MockEC2APIRecorder) DeleteSnapshot(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteSnapshot", arg0)
 }
 
+func (_m *MockEC2API) DeleteSnapshotWithContext(_param0 aws.Context, _param1 *ec2.DeleteSnapshotInput, _param2 ...request.Option) (*ec2.DeleteSnapshotOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteSnapshotWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteSnapshotWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteSnapshotWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteSpotDatafeedSubscriptionRequest(_param0 *ec2.DeleteSpotDatafeedSubscriptionInput) (*request.Request, *ec2.DeleteSpotDatafeedSubscriptionOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteSpotDatafeedSubscriptionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1658,6 +2827,22 @@ func (_mr *_MockEC2APIRecorder) DeleteSpotDatafeedSubscription(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteSpotDatafeedSubscription", arg0)
 }
 
+func (_m *MockEC2API) DeleteSpotDatafeedSubscriptionWithContext(_param0 aws.Context, _param1 *ec2.DeleteSpotDatafeedSubscriptionInput, _param2 ...request.Option) (*ec2.DeleteSpotDatafeedSubscriptionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteSpotDatafeedSubscriptionWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteSpotDatafeedSubscriptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteSpotDatafeedSubscriptionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteSpotDatafeedSubscriptionWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteSubnetRequest(_param0 *ec2.DeleteSubnetInput) (*request.Request, *ec2.DeleteSubnetOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteSubnetRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1680,6 +2865,22 @@ func (_mr *_MockEC2APIRecorder) DeleteSubnet(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteSubnet", arg0)
 }
 
+func (_m *MockEC2API) DeleteSubnetWithContext(_param0 aws.Context, _param1 *ec2.DeleteSubnetInput, _param2 ...request.Option) (*ec2.DeleteSubnetOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteSubnetWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteSubnetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteSubnetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteSubnetWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteTagsRequest(_param0 *ec2.DeleteTagsInput) (*request.Request, *ec2.DeleteTagsOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteTagsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1702,6 +2903,22 @@ func (_mr *_MockEC2APIRecorder) DeleteTags(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTags", arg0)
 }
 
+func (_m *MockEC2API) DeleteTagsWithContext(_param0 aws.Context, _param1 *ec2.DeleteTagsInput, _param2 ...request.Option) (*ec2.DeleteTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteTagsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTagsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteVolumeRequest(_param0 *ec2.DeleteVolumeInput) (*request.Request, *ec2.DeleteVolumeOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteVolumeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1724,6 +2941,22 @@ func (_mr *_MockEC2APIRecorder) DeleteVolume(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVolume", arg0)
 }
 
+func (_m *MockEC2API) DeleteVolumeWithContext(_param0 aws.Context, _param1 *ec2.DeleteVolumeInput, _param2 ...request.Option) (*ec2.DeleteVolumeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteVolumeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteVolumeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteVolumeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVolumeWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteVpcRequest(_param0 *ec2.DeleteVpcInput) (*request.Request, *ec2.DeleteVpcOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteVpcRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1746,6 +2979,22 @@ func (_mr *_MockEC2APIRecorder) DeleteVpc(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpc", arg0)
 }
 
+func (_m *MockEC2API) DeleteVpcWithContext(_param0 aws.Context, _param1 *ec2.DeleteVpcInput, _param2 ...request.Option) (*ec2.DeleteVpcOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteVpcWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteVpcOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteVpcWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpcWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteVpcEndpointsRequest(_param0 *ec2.DeleteVpcEndpointsInput) (*request.Request, *ec2.DeleteVpcEndpointsOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteVpcEndpointsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1768,6 +3017,22 @@ func (_mr *_MockEC2APIRecorder) DeleteVpcEndpoints(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpcEndpoints", arg0)
 }
 
+func (_m *MockEC2API) DeleteVpcEndpointsWithContext(_param0 aws.Context, _param1 *ec2.DeleteVpcEndpointsInput, _param2 ...request.Option) (*ec2.DeleteVpcEndpointsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteVpcEndpointsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteVpcEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteVpcEndpointsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpcEndpointsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteVpcPeeringConnectionRequest(_param0 *ec2.DeleteVpcPeeringConnectionInput) (*request.Request, *ec2.DeleteVpcPeeringConnectionOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteVpcPeeringConnectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1790,6 +3055,22 @@ func (_mr *_MockEC2APIRecorder) DeleteVpcPeeringConnection(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpcPeeringConnection", arg0)
 }
 
+func (_m *MockEC2API) DeleteVpcPeeringConnectionWithContext(_param0 aws.Context, _param1 *ec2.DeleteVpcPeeringConnectionInput, _param2 ...request.Option) (*ec2.DeleteVpcPeeringConnectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteVpcPeeringConnectionWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteVpcPeeringConnectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteVpcPeeringConnectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpcPeeringConnectionWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteVpnConnectionRequest(_param0 *ec2.DeleteVpnConnectionInput) (*request.Request, *ec2.DeleteVpnConnectionOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteVpnConnectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1812,6 +3093,22 @@ func (_mr *_MockEC2APIRecorder) DeleteVpnConnection(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpnConnection", arg0)
 }
 
+func (_m *MockEC2API) DeleteVpnConnectionWithContext(_param0 aws.Context, _param1 *ec2.DeleteVpnConnectionInput, _param2 ...request.Option) (*ec2.DeleteVpnConnectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteVpnConnectionWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteVpnConnectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteVpnConnectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpnConnectionWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteVpnConnectionRouteRequest(_param0 *ec2.DeleteVpnConnectionRouteInput) (*request.Request, *ec2.DeleteVpnConnectionRouteOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteVpnConnectionRouteRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1834,6 +3131,22 @@ func (_mr *_MockEC2APIRecorder) DeleteVpnConnectionRoute(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpnConnectionRoute", arg0)
 }
 
+func (_m *MockEC2API) DeleteVpnConnectionRouteWithContext(_param0 aws.Context, _param1 *ec2.DeleteVpnConnectionRouteInput, _param2 ...request.Option) (*ec2.DeleteVpnConnectionRouteOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteVpnConnectionRouteWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteVpnConnectionRouteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteVpnConnectionRouteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpnConnectionRouteWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeleteVpnGatewayRequest(_param0 *ec2.DeleteVpnGatewayInput) (*request.Request, *ec2.DeleteVpnGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteVpnGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1856,6 +3169,22 @@ func (_mr *_MockEC2APIRecorder) DeleteVpnGateway(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpnGateway", arg0)
 }
 
+func (_m *MockEC2API) DeleteVpnGatewayWithContext(_param0 aws.Context, _param1 *ec2.DeleteVpnGatewayInput, _param2 ...request.Option) (*ec2.DeleteVpnGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteVpnGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeleteVpnGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeleteVpnGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteVpnGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) DeregisterImageRequest(_param0 *ec2.DeregisterImageInput) (*request.Request, *ec2.DeregisterImageOutput) {
 	ret := _m.ctrl.Call(_m, "DeregisterImageRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1878,6 +3207,22 @@ func (_mr *_MockEC2APIRecorder) DeregisterImage(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterImage", arg0)
 }
 
+func (_m *MockEC2API) DeregisterImageWithContext(_param0 aws.Context, _param1 *ec2.DeregisterImageInput, _param2 ...request.Option) (*ec2.DeregisterImageOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeregisterImageWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DeregisterImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DeregisterImageWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterImageWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeAccountAttributesRequest(_param0 *ec2.DescribeAccountAttributesInput) (*request.Request, *ec2.DescribeAccountAttributesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeAccountAttributesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1900,6 +3245,22 @@ func (_mr *_MockEC2APIRecorder) DescribeAccountAttributes(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAccountAttributes", arg0)
 }
 
+func (_m *MockEC2API) DescribeAccountAttributesWithContext(_param0 aws.Context, _param1 *ec2.DescribeAccountAttributesInput, _param2 ...request.Option) (*ec2.DescribeAccountAttributesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAccountAttributesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeAccountAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeAccountAttributesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAccountAttributesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeAddressesRequest(_param0 *ec2.DescribeAddressesInput) (*request.Request, *ec2.DescribeAddressesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeAddressesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1922,6 +3283,22 @@ func (_mr *_MockEC2APIRecorder) DescribeAddresses(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAddresses", arg0)
 }
 
+func (_m *MockEC2API) DescribeAddressesWithContext(_param0 aws.Context, _param1 *ec2.DescribeAddressesInput, _param2 ...request.Option) (*ec2.DescribeAddressesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAddressesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeAddressesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeAddressesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAddressesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeAvailabilityZonesRequest(_param0 *ec2.DescribeAvailabilityZonesInput) (*request.Request, *ec2.DescribeAvailabilityZonesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeAvailabilityZonesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1944,6 +3321,22 @@ func (_mr *_MockEC2APIRecorder) DescribeAvailabilityZones(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAvailabilityZones", arg0)
 }
 
+func (_m *MockEC2API) DescribeAvailabilityZonesWithContext(_param0 aws.Context, _param1 *ec2.DescribeAvailabilityZonesInput, _param2 ...request.Option) (*ec2.DescribeAvailabilityZonesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeAvailabilityZonesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeAvailabilityZonesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeAvailabilityZonesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeAvailabilityZonesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeBundleTasksRequest(_param0 *ec2.DescribeBundleTasksInput) (*request.Request, *ec2.DescribeBundleTasksOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeBundleTasksRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1966,6 +3359,22 @@ func (_mr *_MockEC2APIRecorder) DescribeBundleTasks(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeBundleTasks", arg0)
 }
 
+func (_m *MockEC2API) DescribeBundleTasksWithContext(_param0 aws.Context, _param1 *ec2.DescribeBundleTasksInput, _param2 ...request.Option) (*ec2.DescribeBundleTasksOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeBundleTasksWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeBundleTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeBundleTasksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeBundleTasksWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeClassicLinkInstancesRequest(_param0 *ec2.DescribeClassicLinkInstancesInput) (*request.Request, *ec2.DescribeClassicLinkInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeClassicLinkInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -1988,6 +3397,22 @@ func (_mr *_MockEC2APIRecorder) DescribeClassicLinkInstances(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeClassicLinkInstances", arg0)
 }
 
+func (_m *MockEC2API) DescribeClassicLinkInstancesWithContext(_param0 aws.Context, _param1 *ec2.DescribeClassicLinkInstancesInput, _param2 ...request.Option) (*ec2.DescribeClassicLinkInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeClassicLinkInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeClassicLinkInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeClassicLinkInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeClassicLinkInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeConversionTasksRequest(_param0 *ec2.DescribeConversionTasksInput) (*request.Request, *ec2.DescribeConversionTasksOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeConversionTasksRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2010,6 +3435,22 @@ func (_mr *_MockEC2APIRecorder) DescribeConversionTasks(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeConversionTasks", arg0)
 }
 
+func (_m *MockEC2API) DescribeConversionTasksWithContext(_param0 aws.Context, _param1 *ec2.DescribeConversionTasksInput, _param2 ...request.Option) (*ec2.DescribeConversionTasksOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeConversionTasksWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeConversionTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeConversionTasksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeConversionTasksWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeCustomerGatewaysRequest(_param0 *ec2.DescribeCustomerGatewaysInput) (*request.Request, *ec2.DescribeCustomerGatewaysOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeCustomerGatewaysRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2032,6 +3473,22 @@ func (_mr *_MockEC2APIRecorder) DescribeCustomerGateways(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeCustomerGateways", arg0)
 }
 
+func (_m *MockEC2API) DescribeCustomerGatewaysWithContext(_param0 aws.Context, _param1 *ec2.DescribeCustomerGatewaysInput, _param2 ...request.Option) (*ec2.DescribeCustomerGatewaysOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeCustomerGatewaysWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeCustomerGatewaysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeCustomerGatewaysWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeCustomerGatewaysWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeDhcpOptionsRequest(_param0 *ec2.DescribeDhcpOptionsInput) (*request.Request, *ec2.DescribeDhcpOptionsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeDhcpOptionsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2054,6 +3511,22 @@ func (_mr *_MockEC2APIRecorder) DescribeDhcpOptions(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeDhcpOptions", arg0)
 }
 
+func (_m *MockEC2API) DescribeDhcpOptionsWithContext(_param0 aws.Context, _param1 *ec2.DescribeDhcpOptionsInput, _param2 ...request.Option) (*ec2.DescribeDhcpOptionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeDhcpOptionsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeDhcpOptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeDhcpOptionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeDhcpOptionsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeEgressOnlyInternetGatewaysRequest(_param0 *ec2.DescribeEgressOnlyInternetGatewaysInput) (*request.Request, *ec2.DescribeEgressOnlyInternetGatewaysOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeEgressOnlyInternetGatewaysRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2076,6 +3549,22 @@ func (_mr *_MockEC2APIRecorder) DescribeEgressOnlyInternetGateways(arg0 interfac
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeEgressOnlyInternetGateways", arg0)
 }
 
+func (_m *MockEC2API) DescribeEgressOnlyInternetGatewaysWithContext(_param0 aws.Context, _param1 *ec2.DescribeEgressOnlyInternetGatewaysInput, _param2 ...request.Option) (*ec2.DescribeEgressOnlyInternetGatewaysOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeEgressOnlyInternetGatewaysWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeEgressOnlyInternetGatewaysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeEgressOnlyInternetGatewaysWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeEgressOnlyInternetGatewaysWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeExportTasksRequest(_param0 *ec2.DescribeExportTasksInput) (*request.Request, *ec2.DescribeExportTasksOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeExportTasksRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2098,6 +3587,22 @@ func (_mr *_MockEC2APIRecorder) DescribeExportTasks(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeExportTasks", arg0)
 }
 
+func (_m *MockEC2API) DescribeExportTasksWithContext(_param0 aws.Context, _param1 *ec2.DescribeExportTasksInput, _param2 ...request.Option) (*ec2.DescribeExportTasksOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeExportTasksWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeExportTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeExportTasksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeExportTasksWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeFlowLogsRequest(_param0 *ec2.DescribeFlowLogsInput) (*request.Request, *ec2.DescribeFlowLogsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeFlowLogsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2120,6 +3625,22 @@ func (_mr *_MockEC2APIRecorder) DescribeFlowLogs(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeFlowLogs", arg0)
 }
 
+func (_m *MockEC2API) DescribeFlowLogsWithContext(_param0 aws.Context, _param1 *ec2.DescribeFlowLogsInput, _param2 ...request.Option) (*ec2.DescribeFlowLogsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeFlowLogsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeFlowLogsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeFlowLogsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeFlowLogsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeHostReservationOfferingsRequest(_param0 *ec2.DescribeHostReservationOfferingsInput) (*request.Request, *ec2.DescribeHostReservationOfferingsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeHostReservationOfferingsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2142,6 +3663,22 @@ func (_mr *_MockEC2APIRecorder) DescribeHostReservationOfferings(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeHostReservationOfferings", arg0)
 }
 
+func (_m *MockEC2API) DescribeHostReservationOfferingsWithContext(_param0 aws.Context, _param1 *ec2.DescribeHostReservationOfferingsInput, _param2 ...request.Option) (*ec2.DescribeHostReservationOfferingsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeHostReservationOfferingsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeHostReservationOfferingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeHostReservationOfferingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeHostReservationOfferingsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeHostReservationsRequest(_param0 *ec2.DescribeHostReservationsInput) (*request.Request, *ec2.DescribeHostReservationsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeHostReservationsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2164,6 +3701,22 @@ func (_mr *_MockEC2APIRecorder) DescribeHostReservations(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeHostReservations", arg0)
 }
 
+func (_m *MockEC2API) DescribeHostReservationsWithContext(_param0 aws.Context, _param1 *ec2.DescribeHostReservationsInput, _param2 ...request.Option) (*ec2.DescribeHostReservationsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeHostReservationsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeHostReservationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeHostReservationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeHostReservationsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeHostsRequest(_param0 *ec2.DescribeHostsInput) (*request.Request, *ec2.DescribeHostsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeHostsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2186,6 +3739,22 @@ func (_mr *_MockEC2APIRecorder) DescribeHosts(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeHosts", arg0)
 }
 
+func (_m *MockEC2API) DescribeHostsWithContext(_param0 aws.Context, _param1 *ec2.DescribeHostsInput, _param2 ...request.Option) (*ec2.DescribeHostsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeHostsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeHostsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeHostsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeHostsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeIamInstanceProfileAssociationsRequest(_param0 *ec2.DescribeIamInstanceProfileAssociationsInput) (*request.Request, *ec2.DescribeIamInstanceProfileAssociationsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeIamInstanceProfileAssociationsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2208,6 +3777,22 @@ func (_mr *_MockEC2APIRecorder) DescribeIamInstanceProfileAssociations(arg0 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeIamInstanceProfileAssociations", arg0)
 }
 
+func (_m *MockEC2API) DescribeIamInstanceProfileAssociationsWithContext(_param0 aws.Context, _param1 *ec2.DescribeIamInstanceProfileAssociationsInput, _param2 ...request.Option) (*ec2.DescribeIamInstanceProfileAssociationsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeIamInstanceProfileAssociationsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeIamInstanceProfileAssociationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeIamInstanceProfileAssociationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeIamInstanceProfileAssociationsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeIdFormatRequest(_param0 *ec2.DescribeIdFormatInput) (*request.Request, *ec2.DescribeIdFormatOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeIdFormatRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2230,6 +3815,22 @@ func (_mr *_MockEC2APIRecorder) DescribeIdFormat(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeIdFormat", arg0)
 }
 
+func (_m *MockEC2API) DescribeIdFormatWithContext(_param0 aws.Context, _param1 *ec2.DescribeIdFormatInput, _param2 ...request.Option) (*ec2.DescribeIdFormatOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeIdFormatWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeIdFormatOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeIdFormatWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeIdFormatWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeIdentityIdFormatRequest(_param0 *ec2.DescribeIdentityIdFormatInput) (*request.Request, *ec2.DescribeIdentityIdFormatOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeIdentityIdFormatRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2252,6 +3853,22 @@ func (_mr *_MockEC2APIRecorder) DescribeIdentityIdFormat(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeIdentityIdFormat", arg0)
 }
 
+func (_m *MockEC2API) DescribeIdentityIdFormatWithContext(_param0 aws.Context, _param1 *ec2.DescribeIdentityIdFormatInput, _param2 ...request.Option) (*ec2.DescribeIdentityIdFormatOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeIdentityIdFormatWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeIdentityIdFormatOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeIdentityIdFormatWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeIdentityIdFormatWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeImageAttributeRequest(_param0 *ec2.DescribeImageAttributeInput) (*request.Request, *ec2.DescribeImageAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeImageAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2274,6 +3891,22 @@ func (_mr *_MockEC2APIRecorder) DescribeImageAttribute(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeImageAttribute", arg0)
 }
 
+func (_m *MockEC2API) DescribeImageAttributeWithContext(_param0 aws.Context, _param1 *ec2.DescribeImageAttributeInput, _param2 ...request.Option) (*ec2.DescribeImageAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeImageAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeImageAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeImageAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeImageAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeImagesRequest(_param0 *ec2.DescribeImagesInput) (*request.Request, *ec2.DescribeImagesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeImagesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2296,6 +3929,22 @@ func (_mr *_MockEC2APIRecorder) DescribeImages(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeImages", arg0)
 }
 
+func (_m *MockEC2API) DescribeImagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeImagesInput, _param2 ...request.Option) (*ec2.DescribeImagesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeImagesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeImagesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeImagesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeImagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeImportImageTasksRequest(_param0 *ec2.DescribeImportImageTasksInput) (*request.Request, *ec2.DescribeImportImageTasksOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeImportImageTasksRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2318,6 +3967,22 @@ func (_mr *_MockEC2APIRecorder) DescribeImportImageTasks(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeImportImageTasks", arg0)
 }
 
+func (_m *MockEC2API) DescribeImportImageTasksWithContext(_param0 aws.Context, _param1 *ec2.DescribeImportImageTasksInput, _param2 ...request.Option) (*ec2.DescribeImportImageTasksOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeImportImageTasksWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeImportImageTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeImportImageTasksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeImportImageTasksWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeImportSnapshotTasksRequest(_param0 *ec2.DescribeImportSnapshotTasksInput) (*request.Request, *ec2.DescribeImportSnapshotTasksOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeImportSnapshotTasksRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2340,6 +4005,22 @@ func (_mr *_MockEC2APIRecorder) DescribeImportSnapshotTasks(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeImportSnapshotTasks", arg0)
 }
 
+func (_m *MockEC2API) DescribeImportSnapshotTasksWithContext(_param0 aws.Context, _param1 *ec2.DescribeImportSnapshotTasksInput, _param2 ...request.Option) (*ec2.DescribeImportSnapshotTasksOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeImportSnapshotTasksWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeImportSnapshotTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeImportSnapshotTasksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeImportSnapshotTasksWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeInstanceAttributeRequest(_param0 *ec2.DescribeInstanceAttributeInput) (*request.Request, *ec2.DescribeInstanceAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeInstanceAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2362,6 +4043,22 @@ func (_mr *_MockEC2APIRecorder) DescribeInstanceAttribute(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceAttribute", arg0)
 }
 
+func (_m *MockEC2API) DescribeInstanceAttributeWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstanceAttributeInput, _param2 ...request.Option) (*ec2.DescribeInstanceAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeInstanceAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeInstanceAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeInstanceAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeInstanceStatusRequest(_param0 *ec2.DescribeInstanceStatusInput) (*request.Request, *ec2.DescribeInstanceStatusOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeInstanceStatusRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2384,6 +4081,22 @@ func (_mr *_MockEC2APIRecorder) DescribeInstanceStatus(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceStatus", arg0)
 }
 
+func (_m *MockEC2API) DescribeInstanceStatusWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstanceStatusInput, _param2 ...request.Option) (*ec2.DescribeInstanceStatusOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeInstanceStatusWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeInstanceStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeInstanceStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceStatusWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeInstanceStatusPages(_param0 *ec2.DescribeInstanceStatusInput, _param1 func(*ec2.DescribeInstanceStatusOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeInstanceStatusPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -2394,6 +4107,21 @@ func (_mr *_MockEC2APIRecorder) DescribeInstanceStatusPages(arg0, arg1 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceStatusPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeInstanceStatusPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstanceStatusInput, _param2 func(*ec2.DescribeInstanceStatusOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeInstanceStatusPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeInstanceStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstanceStatusPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeInstancesRequest(_param0 *ec2.DescribeInstancesInput) (*request.Request, *ec2.DescribeInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2416,16 +4144,47 @@ func (_mr *_MockEC2APIRecorder) DescribeInstances(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstances", arg0)
 }
 
-func (_m *MockEC2API) DescribeInstancesPages(_param0 *ec2.DescribeInstancesInput, _param1 func(*ec2.DescribeInstancesOutput, bool) bool) error {
-	ret := _m.ctrl.Call(_m, "DescribeInstancesPages", _param0, _param1)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
+func (_m *MockEC2API) DescribeInstancesWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstancesInput, _param2 ...request.Option) (*ec2.DescribeInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstancesWithContext", _s...)
+}
+
+func (_m *MockEC2API) DescribeInstancesPages(_param0 *ec2.DescribeInstancesInput, _param1 func(*ec2.DescribeInstancesOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "DescribeInstancesPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
 
 func (_mr *_MockEC2APIRecorder) DescribeInstancesPages(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstancesPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeInstancesPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstancesInput, _param2 func(*ec2.DescribeInstancesOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeInstancesPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeInstancesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInstancesPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeInternetGatewaysRequest(_param0 *ec2.DescribeInternetGatewaysInput) (*request.Request, *ec2.DescribeInternetGatewaysOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeInternetGatewaysRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2448,6 +4207,22 @@ func (_mr *_MockEC2APIRecorder) DescribeInternetGateways(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInternetGateways", arg0)
 }
 
+func (_m *MockEC2API) DescribeInternetGatewaysWithContext(_param0 aws.Context, _param1 *ec2.DescribeInternetGatewaysInput, _param2 ...request.Option) (*ec2.DescribeInternetGatewaysOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeInternetGatewaysWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeInternetGatewaysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeInternetGatewaysWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeInternetGatewaysWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeKeyPairsRequest(_param0 *ec2.DescribeKeyPairsInput) (*request.Request, *ec2.DescribeKeyPairsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeKeyPairsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2470,6 +4245,22 @@ func (_mr *_MockEC2APIRecorder) DescribeKeyPairs(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeKeyPairs", arg0)
 }
 
+func (_m *MockEC2API) DescribeKeyPairsWithContext(_param0 aws.Context, _param1 *ec2.DescribeKeyPairsInput, _param2 ...request.Option) (*ec2.DescribeKeyPairsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeKeyPairsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeKeyPairsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeKeyPairsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeKeyPairsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeMovingAddressesRequest(_param0 *ec2.DescribeMovingAddressesInput) (*request.Request, *ec2.DescribeMovingAddressesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeMovingAddressesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2492,6 +4283,22 @@ func (_mr *_MockEC2APIRecorder) DescribeMovingAddresses(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMovingAddresses", arg0)
 }
 
+func (_m *MockEC2API) DescribeMovingAddressesWithContext(_param0 aws.Context, _param1 *ec2.DescribeMovingAddressesInput, _param2 ...request.Option) (*ec2.DescribeMovingAddressesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeMovingAddressesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeMovingAddressesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeMovingAddressesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeMovingAddressesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeNatGatewaysRequest(_param0 *ec2.DescribeNatGatewaysInput) (*request.Request, *ec2.DescribeNatGatewaysOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeNatGatewaysRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2514,6 +4321,22 @@ func (_mr *_MockEC2APIRecorder) DescribeNatGateways(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNatGateways", arg0)
 }
 
+func (_m *MockEC2API) DescribeNatGatewaysWithContext(_param0 aws.Context, _param1 *ec2.DescribeNatGatewaysInput, _param2 ...request.Option) (*ec2.DescribeNatGatewaysOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeNatGatewaysWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeNatGatewaysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeNatGatewaysWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNatGatewaysWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeNatGatewaysPages(_param0 *ec2.DescribeNatGatewaysInput, _param1 func(*ec2.DescribeNatGatewaysOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeNatGatewaysPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -2524,6 +4347,21 @@ func (_mr *_MockEC2APIRecorder) DescribeNatGatewaysPages(arg0, arg1 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNatGatewaysPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeNatGatewaysPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeNatGatewaysInput, _param2 func(*ec2.DescribeNatGatewaysOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeNatGatewaysPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeNatGatewaysPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNatGatewaysPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeNetworkAclsRequest(_param0 *ec2.DescribeNetworkAclsInput) (*request.Request, *ec2.DescribeNetworkAclsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeNetworkAclsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2546,6 +4384,22 @@ func (_mr *_MockEC2APIRecorder) DescribeNetworkAcls(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNetworkAcls", arg0)
 }
 
+func (_m *MockEC2API) DescribeNetworkAclsWithContext(_param0 aws.Context, _param1 *ec2.DescribeNetworkAclsInput, _param2 ...request.Option) (*ec2.DescribeNetworkAclsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeNetworkAclsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeNetworkAclsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeNetworkAclsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNetworkAclsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeNetworkInterfaceAttributeRequest(_param0 *ec2.DescribeNetworkInterfaceAttributeInput) (*request.Request, *ec2.DescribeNetworkInterfaceAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeNetworkInterfaceAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2568,6 +4422,22 @@ func (_mr *_MockEC2APIRecorder) DescribeNetworkInterfaceAttribute(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNetworkInterfaceAttribute", arg0)
 }
 
+func (_m *MockEC2API) DescribeNetworkInterfaceAttributeWithContext(_param0 aws.Context, _param1 *ec2.DescribeNetworkInterfaceAttributeInput, _param2 ...request.Option) (*ec2.DescribeNetworkInterfaceAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeNetworkInterfaceAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeNetworkInterfaceAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeNetworkInterfaceAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNetworkInterfaceAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeNetworkInterfacesRequest(_param0 *ec2.DescribeNetworkInterfacesInput) (*request.Request, *ec2.DescribeNetworkInterfacesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeNetworkInterfacesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2590,6 +4460,22 @@ func (_mr *_MockEC2APIRecorder) DescribeNetworkInterfaces(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNetworkInterfaces", arg0)
 }
 
+func (_m *MockEC2API) DescribeNetworkInterfacesWithContext(_param0 aws.Context, _param1 *ec2.DescribeNetworkInterfacesInput, _param2 ...request.Option) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeNetworkInterfacesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeNetworkInterfacesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeNetworkInterfacesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeNetworkInterfacesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribePlacementGroupsRequest(_param0 *ec2.DescribePlacementGroupsInput) (*request.Request, *ec2.DescribePlacementGroupsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribePlacementGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2612,6 +4498,22 @@ func (_mr *_MockEC2APIRecorder) DescribePlacementGroups(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePlacementGroups", arg0)
 }
 
+func (_m *MockEC2API) DescribePlacementGroupsWithContext(_param0 aws.Context, _param1 *ec2.DescribePlacementGroupsInput, _param2 ...request.Option) (*ec2.DescribePlacementGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribePlacementGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribePlacementGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribePlacementGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePlacementGroupsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribePrefixListsRequest(_param0 *ec2.DescribePrefixListsInput) (*request.Request, *ec2.DescribePrefixListsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribePrefixListsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2634,6 +4536,22 @@ func (_mr *_MockEC2APIRecorder) DescribePrefixLists(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePrefixLists", arg0)
 }
 
+func (_m *MockEC2API) DescribePrefixListsWithContext(_param0 aws.Context, _param1 *ec2.DescribePrefixListsInput, _param2 ...request.Option) (*ec2.DescribePrefixListsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribePrefixListsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribePrefixListsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribePrefixListsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribePrefixListsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeRegionsRequest(_param0 *ec2.DescribeRegionsInput) (*request.Request, *ec2.DescribeRegionsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeRegionsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2656,6 +4574,22 @@ func (_mr *_MockEC2APIRecorder) DescribeRegions(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeRegions", arg0)
 }
 
+func (_m *MockEC2API) DescribeRegionsWithContext(_param0 aws.Context, _param1 *ec2.DescribeRegionsInput, _param2 ...request.Option) (*ec2.DescribeRegionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeRegionsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeRegionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeRegionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeRegionsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeReservedInstancesRequest(_param0 *ec2.DescribeReservedInstancesInput) (*request.Request, *ec2.DescribeReservedInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2678,6 +4612,22 @@ func (_mr *_MockEC2APIRecorder) DescribeReservedInstances(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstances", arg0)
 }
 
+func (_m *MockEC2API) DescribeReservedInstancesWithContext(_param0 aws.Context, _param1 *ec2.DescribeReservedInstancesInput, _param2 ...request.Option) (*ec2.DescribeReservedInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeReservedInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeReservedInstancesListingsRequest(_param0 *ec2.DescribeReservedInstancesListingsInput) (*request.Request, *ec2.DescribeReservedInstancesListingsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesListingsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2700,6 +4650,22 @@ func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesListings(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesListings", arg0)
 }
 
+func (_m *MockEC2API) DescribeReservedInstancesListingsWithContext(_param0 aws.Context, _param1 *ec2.DescribeReservedInstancesListingsInput, _param2 ...request.Option) (*ec2.DescribeReservedInstancesListingsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesListingsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeReservedInstancesListingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesListingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesListingsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeReservedInstancesModificationsRequest(_param0 *ec2.DescribeReservedInstancesModificationsInput) (*request.Request, *ec2.DescribeReservedInstancesModificationsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesModificationsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2722,6 +4688,22 @@ func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesModifications(arg0 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesModifications", arg0)
 }
 
+func (_m *MockEC2API) DescribeReservedInstancesModificationsWithContext(_param0 aws.Context, _param1 *ec2.DescribeReservedInstancesModificationsInput, _param2 ...request.Option) (*ec2.DescribeReservedInstancesModificationsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesModificationsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeReservedInstancesModificationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesModificationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesModificationsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeReservedInstancesModificationsPages(_param0 *ec2.DescribeReservedInstancesModificationsInput, _param1 func(*ec2.DescribeReservedInstancesModificationsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesModificationsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -2732,6 +4714,21 @@ func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesModificationsPages(arg0
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesModificationsPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeReservedInstancesModificationsPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeReservedInstancesModificationsInput, _param2 func(*ec2.DescribeReservedInstancesModificationsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesModificationsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesModificationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesModificationsPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeReservedInstancesOfferingsRequest(_param0 *ec2.DescribeReservedInstancesOfferingsInput) (*request.Request, *ec2.DescribeReservedInstancesOfferingsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesOfferingsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2754,6 +4751,22 @@ func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesOfferings(arg0 interfac
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesOfferings", arg0)
 }
 
+func (_m *MockEC2API) DescribeReservedInstancesOfferingsWithContext(_param0 aws.Context, _param1 *ec2.DescribeReservedInstancesOfferingsInput, _param2 ...request.Option) (*ec2.DescribeReservedInstancesOfferingsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesOfferingsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeReservedInstancesOfferingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesOfferingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesOfferingsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeReservedInstancesOfferingsPages(_param0 *ec2.DescribeReservedInstancesOfferingsInput, _param1 func(*ec2.DescribeReservedInstancesOfferingsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesOfferingsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -2764,6 +4777,21 @@ func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesOfferingsPages(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesOfferingsPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeReservedInstancesOfferingsPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeReservedInstancesOfferingsInput, _param2 func(*ec2.DescribeReservedInstancesOfferingsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeReservedInstancesOfferingsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeReservedInstancesOfferingsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeReservedInstancesOfferingsPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeRouteTablesRequest(_param0 *ec2.DescribeRouteTablesInput) (*request.Request, *ec2.DescribeRouteTablesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeRouteTablesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2786,6 +4814,22 @@ func (_mr *_MockEC2APIRecorder) DescribeRouteTables(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeRouteTables", arg0)
 }
 
+func (_m *MockEC2API) DescribeRouteTablesWithContext(_param0 aws.Context, _param1 *ec2.DescribeRouteTablesInput, _param2 ...request.Option) (*ec2.DescribeRouteTablesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeRouteTablesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeRouteTablesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeRouteTablesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeRouteTablesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeScheduledInstanceAvailabilityRequest(_param0 *ec2.DescribeScheduledInstanceAvailabilityInput) (*request.Request, *ec2.DescribeScheduledInstanceAvailabilityOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeScheduledInstanceAvailabilityRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2808,6 +4852,22 @@ func (_mr *_MockEC2APIRecorder) DescribeScheduledInstanceAvailability(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledInstanceAvailability", arg0)
 }
 
+func (_m *MockEC2API) DescribeScheduledInstanceAvailabilityWithContext(_param0 aws.Context, _param1 *ec2.DescribeScheduledInstanceAvailabilityInput, _param2 ...request.Option) (*ec2.DescribeScheduledInstanceAvailabilityOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScheduledInstanceAvailabilityWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeScheduledInstanceAvailabilityOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeScheduledInstanceAvailabilityWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledInstanceAvailabilityWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeScheduledInstancesRequest(_param0 *ec2.DescribeScheduledInstancesInput) (*request.Request, *ec2.DescribeScheduledInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeScheduledInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2830,6 +4890,22 @@ func (_mr *_MockEC2APIRecorder) DescribeScheduledInstances(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledInstances", arg0)
 }
 
+func (_m *MockEC2API) DescribeScheduledInstancesWithContext(_param0 aws.Context, _param1 *ec2.DescribeScheduledInstancesInput, _param2 ...request.Option) (*ec2.DescribeScheduledInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeScheduledInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeScheduledInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeScheduledInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeScheduledInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSecurityGroupReferencesRequest(_param0 *ec2.DescribeSecurityGroupReferencesInput) (*request.Request, *ec2.DescribeSecurityGroupReferencesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSecurityGroupReferencesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2852,6 +4928,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSecurityGroupReferences(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSecurityGroupReferences", arg0)
 }
 
+func (_m *MockEC2API) DescribeSecurityGroupReferencesWithContext(_param0 aws.Context, _param1 *ec2.DescribeSecurityGroupReferencesInput, _param2 ...request.Option) (*ec2.DescribeSecurityGroupReferencesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSecurityGroupReferencesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSecurityGroupReferencesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSecurityGroupReferencesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSecurityGroupReferencesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSecurityGroupsRequest(_param0 *ec2.DescribeSecurityGroupsInput) (*request.Request, *ec2.DescribeSecurityGroupsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSecurityGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2874,6 +4966,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSecurityGroups(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSecurityGroups", arg0)
 }
 
+func (_m *MockEC2API) DescribeSecurityGroupsWithContext(_param0 aws.Context, _param1 *ec2.DescribeSecurityGroupsInput, _param2 ...request.Option) (*ec2.DescribeSecurityGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSecurityGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSecurityGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSecurityGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSecurityGroupsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSnapshotAttributeRequest(_param0 *ec2.DescribeSnapshotAttributeInput) (*request.Request, *ec2.DescribeSnapshotAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSnapshotAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2896,6 +5004,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSnapshotAttribute(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSnapshotAttribute", arg0)
 }
 
+func (_m *MockEC2API) DescribeSnapshotAttributeWithContext(_param0 aws.Context, _param1 *ec2.DescribeSnapshotAttributeInput, _param2 ...request.Option) (*ec2.DescribeSnapshotAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSnapshotAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSnapshotAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSnapshotAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSnapshotAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSnapshotsRequest(_param0 *ec2.DescribeSnapshotsInput) (*request.Request, *ec2.DescribeSnapshotsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSnapshotsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2918,6 +5042,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSnapshots(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSnapshots", arg0)
 }
 
+func (_m *MockEC2API) DescribeSnapshotsWithContext(_param0 aws.Context, _param1 *ec2.DescribeSnapshotsInput, _param2 ...request.Option) (*ec2.DescribeSnapshotsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSnapshotsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSnapshotsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSnapshotsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSnapshotsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSnapshotsPages(_param0 *ec2.DescribeSnapshotsInput, _param1 func(*ec2.DescribeSnapshotsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeSnapshotsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -2928,6 +5068,21 @@ func (_mr *_MockEC2APIRecorder) DescribeSnapshotsPages(arg0, arg1 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSnapshotsPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeSnapshotsPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeSnapshotsInput, _param2 func(*ec2.DescribeSnapshotsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSnapshotsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSnapshotsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSnapshotsPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSpotDatafeedSubscriptionRequest(_param0 *ec2.DescribeSpotDatafeedSubscriptionInput) (*request.Request, *ec2.DescribeSpotDatafeedSubscriptionOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSpotDatafeedSubscriptionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2950,6 +5105,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSpotDatafeedSubscription(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotDatafeedSubscription", arg0)
 }
 
+func (_m *MockEC2API) DescribeSpotDatafeedSubscriptionWithContext(_param0 aws.Context, _param1 *ec2.DescribeSpotDatafeedSubscriptionInput, _param2 ...request.Option) (*ec2.DescribeSpotDatafeedSubscriptionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSpotDatafeedSubscriptionWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSpotDatafeedSubscriptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSpotDatafeedSubscriptionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotDatafeedSubscriptionWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSpotFleetInstancesRequest(_param0 *ec2.DescribeSpotFleetInstancesInput) (*request.Request, *ec2.DescribeSpotFleetInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSpotFleetInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2972,6 +5143,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSpotFleetInstances(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotFleetInstances", arg0)
 }
 
+func (_m *MockEC2API) DescribeSpotFleetInstancesWithContext(_param0 aws.Context, _param1 *ec2.DescribeSpotFleetInstancesInput, _param2 ...request.Option) (*ec2.DescribeSpotFleetInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSpotFleetInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSpotFleetInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSpotFleetInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotFleetInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSpotFleetRequestHistoryRequest(_param0 *ec2.DescribeSpotFleetRequestHistoryInput) (*request.Request, *ec2.DescribeSpotFleetRequestHistoryOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSpotFleetRequestHistoryRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -2994,6 +5181,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSpotFleetRequestHistory(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotFleetRequestHistory", arg0)
 }
 
+func (_m *MockEC2API) DescribeSpotFleetRequestHistoryWithContext(_param0 aws.Context, _param1 *ec2.DescribeSpotFleetRequestHistoryInput, _param2 ...request.Option) (*ec2.DescribeSpotFleetRequestHistoryOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSpotFleetRequestHistoryWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSpotFleetRequestHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSpotFleetRequestHistoryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotFleetRequestHistoryWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSpotFleetRequestsRequest(_param0 *ec2.DescribeSpotFleetRequestsInput) (*request.Request, *ec2.DescribeSpotFleetRequestsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSpotFleetRequestsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3016,6 +5219,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSpotFleetRequests(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotFleetRequests", arg0)
 }
 
+func (_m *MockEC2API) DescribeSpotFleetRequestsWithContext(_param0 aws.Context, _param1 *ec2.DescribeSpotFleetRequestsInput, _param2 ...request.Option) (*ec2.DescribeSpotFleetRequestsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSpotFleetRequestsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSpotFleetRequestsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSpotFleetRequestsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotFleetRequestsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSpotFleetRequestsPages(_param0 *ec2.DescribeSpotFleetRequestsInput, _param1 func(*ec2.DescribeSpotFleetRequestsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeSpotFleetRequestsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -3026,6 +5245,21 @@ func (_mr *_MockEC2APIRecorder) DescribeSpotFleetRequestsPages(arg0, arg1 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotFleetRequestsPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeSpotFleetRequestsPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeSpotFleetRequestsInput, _param2 func(*ec2.DescribeSpotFleetRequestsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSpotFleetRequestsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSpotFleetRequestsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotFleetRequestsPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSpotInstanceRequestsRequest(_param0 *ec2.DescribeSpotInstanceRequestsInput) (*request.Request, *ec2.DescribeSpotInstanceRequestsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSpotInstanceRequestsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3048,6 +5282,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSpotInstanceRequests(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotInstanceRequests", arg0)
 }
 
+func (_m *MockEC2API) DescribeSpotInstanceRequestsWithContext(_param0 aws.Context, _param1 *ec2.DescribeSpotInstanceRequestsInput, _param2 ...request.Option) (*ec2.DescribeSpotInstanceRequestsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSpotInstanceRequestsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSpotInstanceRequestsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSpotInstanceRequestsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotInstanceRequestsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSpotPriceHistoryRequest(_param0 *ec2.DescribeSpotPriceHistoryInput) (*request.Request, *ec2.DescribeSpotPriceHistoryOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSpotPriceHistoryRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3070,6 +5320,22 @@ func (_mr *_MockEC2APIRecorder) DescribeSpotPriceHistory(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotPriceHistory", arg0)
 }
 
+func (_m *MockEC2API) DescribeSpotPriceHistoryWithContext(_param0 aws.Context, _param1 *ec2.DescribeSpotPriceHistoryInput, _param2 ...request.Option) (*ec2.DescribeSpotPriceHistoryOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSpotPriceHistoryWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSpotPriceHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSpotPriceHistoryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotPriceHistoryWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSpotPriceHistoryPages(_param0 *ec2.DescribeSpotPriceHistoryInput, _param1 func(*ec2.DescribeSpotPriceHistoryOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeSpotPriceHistoryPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -3080,6 +5346,21 @@ func (_mr *_MockEC2APIRecorder) DescribeSpotPriceHistoryPages(arg0, arg1 interfa
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotPriceHistoryPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeSpotPriceHistoryPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeSpotPriceHistoryInput, _param2 func(*ec2.DescribeSpotPriceHistoryOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSpotPriceHistoryPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSpotPriceHistoryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSpotPriceHistoryPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeStaleSecurityGroupsRequest(_param0 *ec2.DescribeStaleSecurityGroupsInput) (*request.Request, *ec2.DescribeStaleSecurityGroupsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeStaleSecurityGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3102,6 +5383,22 @@ func (_mr *_MockEC2APIRecorder) DescribeStaleSecurityGroups(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeStaleSecurityGroups", arg0)
 }
 
+func (_m *MockEC2API) DescribeStaleSecurityGroupsWithContext(_param0 aws.Context, _param1 *ec2.DescribeStaleSecurityGroupsInput, _param2 ...request.Option) (*ec2.DescribeStaleSecurityGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeStaleSecurityGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeStaleSecurityGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeStaleSecurityGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeStaleSecurityGroupsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeSubnetsRequest(_param0 *ec2.DescribeSubnetsInput) (*request.Request, *ec2.DescribeSubnetsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSubnetsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3124,10 +5421,26 @@ func (_mr *_MockEC2APIRecorder) DescribeSubnets(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSubnets", arg0)
 }
 
-func (_m *MockEC2API) DescribeTagsRequest(_param0 *ec2.DescribeTagsInput) (*request.Request, *ec2.DescribeTagsOutput) {
-	ret := _m.ctrl.Call(_m, "DescribeTagsRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*ec2.DescribeTagsOutput)
+func (_m *MockEC2API) DescribeSubnetsWithContext(_param0 aws.Context, _param1 *ec2.DescribeSubnetsInput, _param2 ...request.Option) (*ec2.DescribeSubnetsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSubnetsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeSubnetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeSubnetsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSubnetsWithContext", _s...)
+}
+
+func (_m *MockEC2API) DescribeTagsRequest(_param0 *ec2.DescribeTagsInput) (*request.Request, *ec2.DescribeTagsOutput) {
+	ret := _m.ctrl.Call(_m, "DescribeTagsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ec2.DescribeTagsOutput)
 	return ret0, ret1
 }
 
@@ -3146,6 +5459,22 @@ func (_mr *_MockEC2APIRecorder) DescribeTags(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTags", arg0)
 }
 
+func (_m *MockEC2API) DescribeTagsWithContext(_param0 aws.Context, _param1 *ec2.DescribeTagsInput, _param2 ...request.Option) (*ec2.DescribeTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTagsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeTagsPages(_param0 *ec2.DescribeTagsInput, _param1 func(*ec2.DescribeTagsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeTagsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -3156,6 +5485,21 @@ func (_mr *_MockEC2APIRecorder) DescribeTagsPages(arg0, arg1 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeTagsPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeTagsInput, _param2 func(*ec2.DescribeTagsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTagsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeTagsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVolumeAttributeRequest(_param0 *ec2.DescribeVolumeAttributeInput) (*request.Request, *ec2.DescribeVolumeAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVolumeAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3178,6 +5522,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVolumeAttribute(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumeAttribute", arg0)
 }
 
+func (_m *MockEC2API) DescribeVolumeAttributeWithContext(_param0 aws.Context, _param1 *ec2.DescribeVolumeAttributeInput, _param2 ...request.Option) (*ec2.DescribeVolumeAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVolumeAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVolumeAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVolumeAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumeAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVolumeStatusRequest(_param0 *ec2.DescribeVolumeStatusInput) (*request.Request, *ec2.DescribeVolumeStatusOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVolumeStatusRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3200,6 +5560,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVolumeStatus(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumeStatus", arg0)
 }
 
+func (_m *MockEC2API) DescribeVolumeStatusWithContext(_param0 aws.Context, _param1 *ec2.DescribeVolumeStatusInput, _param2 ...request.Option) (*ec2.DescribeVolumeStatusOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVolumeStatusWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVolumeStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVolumeStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumeStatusWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVolumeStatusPages(_param0 *ec2.DescribeVolumeStatusInput, _param1 func(*ec2.DescribeVolumeStatusOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeVolumeStatusPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -3210,6 +5586,21 @@ func (_mr *_MockEC2APIRecorder) DescribeVolumeStatusPages(arg0, arg1 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumeStatusPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeVolumeStatusPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeVolumeStatusInput, _param2 func(*ec2.DescribeVolumeStatusOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVolumeStatusPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVolumeStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumeStatusPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVolumesRequest(_param0 *ec2.DescribeVolumesInput) (*request.Request, *ec2.DescribeVolumesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVolumesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3232,6 +5623,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVolumes(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumes", arg0)
 }
 
+func (_m *MockEC2API) DescribeVolumesWithContext(_param0 aws.Context, _param1 *ec2.DescribeVolumesInput, _param2 ...request.Option) (*ec2.DescribeVolumesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVolumesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVolumesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVolumesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVolumesPages(_param0 *ec2.DescribeVolumesInput, _param1 func(*ec2.DescribeVolumesOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeVolumesPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -3242,6 +5649,21 @@ func (_mr *_MockEC2APIRecorder) DescribeVolumesPages(arg0, arg1 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumesPages", arg0, arg1)
 }
 
+func (_m *MockEC2API) DescribeVolumesPagesWithContext(_param0 aws.Context, _param1 *ec2.DescribeVolumesInput, _param2 func(*ec2.DescribeVolumesOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVolumesPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVolumesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumesPagesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVolumesModificationsRequest(_param0 *ec2.DescribeVolumesModificationsInput) (*request.Request, *ec2.DescribeVolumesModificationsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVolumesModificationsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3264,6 +5686,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVolumesModifications(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumesModifications", arg0)
 }
 
+func (_m *MockEC2API) DescribeVolumesModificationsWithContext(_param0 aws.Context, _param1 *ec2.DescribeVolumesModificationsInput, _param2 ...request.Option) (*ec2.DescribeVolumesModificationsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVolumesModificationsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVolumesModificationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVolumesModificationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVolumesModificationsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVpcAttributeRequest(_param0 *ec2.DescribeVpcAttributeInput) (*request.Request, *ec2.DescribeVpcAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVpcAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3286,6 +5724,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVpcAttribute(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcAttribute", arg0)
 }
 
+func (_m *MockEC2API) DescribeVpcAttributeWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcAttributeInput, _param2 ...request.Option) (*ec2.DescribeVpcAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVpcAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVpcAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVpcAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVpcClassicLinkRequest(_param0 *ec2.DescribeVpcClassicLinkInput) (*request.Request, *ec2.DescribeVpcClassicLinkOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVpcClassicLinkRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3308,6 +5762,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVpcClassicLink(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcClassicLink", arg0)
 }
 
+func (_m *MockEC2API) DescribeVpcClassicLinkWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcClassicLinkInput, _param2 ...request.Option) (*ec2.DescribeVpcClassicLinkOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVpcClassicLinkWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVpcClassicLinkOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVpcClassicLinkWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcClassicLinkWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVpcClassicLinkDnsSupportRequest(_param0 *ec2.DescribeVpcClassicLinkDnsSupportInput) (*request.Request, *ec2.DescribeVpcClassicLinkDnsSupportOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVpcClassicLinkDnsSupportRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3330,6 +5800,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVpcClassicLinkDnsSupport(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcClassicLinkDnsSupport", arg0)
 }
 
+func (_m *MockEC2API) DescribeVpcClassicLinkDnsSupportWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcClassicLinkDnsSupportInput, _param2 ...request.Option) (*ec2.DescribeVpcClassicLinkDnsSupportOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVpcClassicLinkDnsSupportWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVpcClassicLinkDnsSupportOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVpcClassicLinkDnsSupportWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcClassicLinkDnsSupportWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVpcEndpointServicesRequest(_param0 *ec2.DescribeVpcEndpointServicesInput) (*request.Request, *ec2.DescribeVpcEndpointServicesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVpcEndpointServicesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3352,6 +5838,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVpcEndpointServices(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcEndpointServices", arg0)
 }
 
+func (_m *MockEC2API) DescribeVpcEndpointServicesWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcEndpointServicesInput, _param2 ...request.Option) (*ec2.DescribeVpcEndpointServicesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVpcEndpointServicesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVpcEndpointServicesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVpcEndpointServicesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcEndpointServicesWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVpcEndpointsRequest(_param0 *ec2.DescribeVpcEndpointsInput) (*request.Request, *ec2.DescribeVpcEndpointsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVpcEndpointsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3374,6 +5876,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVpcEndpoints(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcEndpoints", arg0)
 }
 
+func (_m *MockEC2API) DescribeVpcEndpointsWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcEndpointsInput, _param2 ...request.Option) (*ec2.DescribeVpcEndpointsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVpcEndpointsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVpcEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVpcEndpointsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcEndpointsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVpcPeeringConnectionsRequest(_param0 *ec2.DescribeVpcPeeringConnectionsInput) (*request.Request, *ec2.DescribeVpcPeeringConnectionsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVpcPeeringConnectionsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3396,6 +5914,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVpcPeeringConnections(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcPeeringConnections", arg0)
 }
 
+func (_m *MockEC2API) DescribeVpcPeeringConnectionsWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcPeeringConnectionsInput, _param2 ...request.Option) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVpcPeeringConnectionsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVpcPeeringConnectionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVpcPeeringConnectionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcPeeringConnectionsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVpcsRequest(_param0 *ec2.DescribeVpcsInput) (*request.Request, *ec2.DescribeVpcsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVpcsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3418,6 +5952,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVpcs(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcs", arg0)
 }
 
+func (_m *MockEC2API) DescribeVpcsWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcsInput, _param2 ...request.Option) (*ec2.DescribeVpcsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVpcsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVpcsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVpcsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpcsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVpnConnectionsRequest(_param0 *ec2.DescribeVpnConnectionsInput) (*request.Request, *ec2.DescribeVpnConnectionsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVpnConnectionsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3440,6 +5990,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVpnConnections(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpnConnections", arg0)
 }
 
+func (_m *MockEC2API) DescribeVpnConnectionsWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpnConnectionsInput, _param2 ...request.Option) (*ec2.DescribeVpnConnectionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVpnConnectionsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVpnConnectionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVpnConnectionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpnConnectionsWithContext", _s...)
+}
+
 func (_m *MockEC2API) DescribeVpnGatewaysRequest(_param0 *ec2.DescribeVpnGatewaysInput) (*request.Request, *ec2.DescribeVpnGatewaysOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeVpnGatewaysRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3462,6 +6028,22 @@ func (_mr *_MockEC2APIRecorder) DescribeVpnGateways(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpnGateways", arg0)
 }
 
+func (_m *MockEC2API) DescribeVpnGatewaysWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpnGatewaysInput, _param2 ...request.Option) (*ec2.DescribeVpnGatewaysOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeVpnGatewaysWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DescribeVpnGatewaysOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DescribeVpnGatewaysWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeVpnGatewaysWithContext", _s...)
+}
+
 func (_m *MockEC2API) DetachClassicLinkVpcRequest(_param0 *ec2.DetachClassicLinkVpcInput) (*request.Request, *ec2.DetachClassicLinkVpcOutput) {
 	ret := _m.ctrl.Call(_m, "DetachClassicLinkVpcRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3484,6 +6066,22 @@ func (_mr *_MockEC2APIRecorder) DetachClassicLinkVpc(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachClassicLinkVpc", arg0)
 }
 
+func (_m *MockEC2API) DetachClassicLinkVpcWithContext(_param0 aws.Context, _param1 *ec2.DetachClassicLinkVpcInput, _param2 ...request.Option) (*ec2.DetachClassicLinkVpcOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachClassicLinkVpcWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DetachClassicLinkVpcOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DetachClassicLinkVpcWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachClassicLinkVpcWithContext", _s...)
+}
+
 func (_m *MockEC2API) DetachInternetGatewayRequest(_param0 *ec2.DetachInternetGatewayInput) (*request.Request, *ec2.DetachInternetGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "DetachInternetGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3506,6 +6104,22 @@ func (_mr *_MockEC2APIRecorder) DetachInternetGateway(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachInternetGateway", arg0)
 }
 
+func (_m *MockEC2API) DetachInternetGatewayWithContext(_param0 aws.Context, _param1 *ec2.DetachInternetGatewayInput, _param2 ...request.Option) (*ec2.DetachInternetGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachInternetGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DetachInternetGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DetachInternetGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachInternetGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) DetachNetworkInterfaceRequest(_param0 *ec2.DetachNetworkInterfaceInput) (*request.Request, *ec2.DetachNetworkInterfaceOutput) {
 	ret := _m.ctrl.Call(_m, "DetachNetworkInterfaceRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3528,6 +6142,22 @@ func (_mr *_MockEC2APIRecorder) DetachNetworkInterface(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachNetworkInterface", arg0)
 }
 
+func (_m *MockEC2API) DetachNetworkInterfaceWithContext(_param0 aws.Context, _param1 *ec2.DetachNetworkInterfaceInput, _param2 ...request.Option) (*ec2.DetachNetworkInterfaceOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachNetworkInterfaceWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DetachNetworkInterfaceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DetachNetworkInterfaceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachNetworkInterfaceWithContext", _s...)
+}
+
 func (_m *MockEC2API) DetachVolumeRequest(_param0 *ec2.DetachVolumeInput) (*request.Request, *ec2.VolumeAttachment) {
 	ret := _m.ctrl.Call(_m, "DetachVolumeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3550,6 +6180,22 @@ func (_mr *_MockEC2APIRecorder) DetachVolume(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachVolume", arg0)
 }
 
+func (_m *MockEC2API) DetachVolumeWithContext(_param0 aws.Context, _param1 *ec2.DetachVolumeInput, _param2 ...request.Option) (*ec2.VolumeAttachment, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachVolumeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.VolumeAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DetachVolumeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachVolumeWithContext", _s...)
+}
+
 func (_m *MockEC2API) DetachVpnGatewayRequest(_param0 *ec2.DetachVpnGatewayInput) (*request.Request, *ec2.DetachVpnGatewayOutput) {
 	ret := _m.ctrl.Call(_m, "DetachVpnGatewayRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3572,6 +6218,22 @@ func (_mr *_MockEC2APIRecorder) DetachVpnGateway(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachVpnGateway", arg0)
 }
 
+func (_m *MockEC2API) DetachVpnGatewayWithContext(_param0 aws.Context, _param1 *ec2.DetachVpnGatewayInput, _param2 ...request.Option) (*ec2.DetachVpnGatewayOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DetachVpnGatewayWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DetachVpnGatewayOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DetachVpnGatewayWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachVpnGatewayWithContext", _s...)
+}
+
 func (_m *MockEC2API) DisableVgwRoutePropagationRequest(_param0 *ec2.DisableVgwRoutePropagationInput) (*request.Request, *ec2.DisableVgwRoutePropagationOutput) {
 	ret := _m.ctrl.Call(_m, "DisableVgwRoutePropagationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3594,6 +6256,22 @@ func (_mr *_MockEC2APIRecorder) DisableVgwRoutePropagation(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableVgwRoutePropagation", arg0)
 }
 
+func (_m *MockEC2API) DisableVgwRoutePropagationWithContext(_param0 aws.Context, _param1 *ec2.DisableVgwRoutePropagationInput, _param2 ...request.Option) (*ec2.DisableVgwRoutePropagationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisableVgwRoutePropagationWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DisableVgwRoutePropagationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DisableVgwRoutePropagationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableVgwRoutePropagationWithContext", _s...)
+}
+
 func (_m *MockEC2API) DisableVpcClassicLinkRequest(_param0 *ec2.DisableVpcClassicLinkInput) (*request.Request, *ec2.DisableVpcClassicLinkOutput) {
 	ret := _m.ctrl.Call(_m, "DisableVpcClassicLinkRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3616,6 +6294,22 @@ func (_mr *_MockEC2APIRecorder) DisableVpcClassicLink(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableVpcClassicLink", arg0)
 }
 
+func (_m *MockEC2API) DisableVpcClassicLinkWithContext(_param0 aws.Context, _param1 *ec2.DisableVpcClassicLinkInput, _param2 ...request.Option) (*ec2.DisableVpcClassicLinkOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisableVpcClassicLinkWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DisableVpcClassicLinkOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DisableVpcClassicLinkWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableVpcClassicLinkWithContext", _s...)
+}
+
 func (_m *MockEC2API) DisableVpcClassicLinkDnsSupportRequest(_param0 *ec2.DisableVpcClassicLinkDnsSupportInput) (*request.Request, *ec2.DisableVpcClassicLinkDnsSupportOutput) {
 	ret := _m.ctrl.Call(_m, "DisableVpcClassicLinkDnsSupportRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3638,6 +6332,22 @@ func (_mr *_MockEC2APIRecorder) DisableVpcClassicLinkDnsSupport(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableVpcClassicLinkDnsSupport", arg0)
 }
 
+func (_m *MockEC2API) DisableVpcClassicLinkDnsSupportWithContext(_param0 aws.Context, _param1 *ec2.DisableVpcClassicLinkDnsSupportInput, _param2 ...request.Option) (*ec2.DisableVpcClassicLinkDnsSupportOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisableVpcClassicLinkDnsSupportWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DisableVpcClassicLinkDnsSupportOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DisableVpcClassicLinkDnsSupportWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableVpcClassicLinkDnsSupportWithContext", _s...)
+}
+
 func (_m *MockEC2API) DisassociateAddressRequest(_param0 *ec2.DisassociateAddressInput) (*request.Request, *ec2.DisassociateAddressOutput) {
 	ret := _m.ctrl.Call(_m, "DisassociateAddressRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3660,6 +6370,22 @@ func (_mr *_MockEC2APIRecorder) DisassociateAddress(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateAddress", arg0)
 }
 
+func (_m *MockEC2API) DisassociateAddressWithContext(_param0 aws.Context, _param1 *ec2.DisassociateAddressInput, _param2 ...request.Option) (*ec2.DisassociateAddressOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisassociateAddressWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DisassociateAddressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DisassociateAddressWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateAddressWithContext", _s...)
+}
+
 func (_m *MockEC2API) DisassociateIamInstanceProfileRequest(_param0 *ec2.DisassociateIamInstanceProfileInput) (*request.Request, *ec2.DisassociateIamInstanceProfileOutput) {
 	ret := _m.ctrl.Call(_m, "DisassociateIamInstanceProfileRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3682,6 +6408,22 @@ func (_mr *_MockEC2APIRecorder) DisassociateIamInstanceProfile(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateIamInstanceProfile", arg0)
 }
 
+func (_m *MockEC2API) DisassociateIamInstanceProfileWithContext(_param0 aws.Context, _param1 *ec2.DisassociateIamInstanceProfileInput, _param2 ...request.Option) (*ec2.DisassociateIamInstanceProfileOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisassociateIamInstanceProfileWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DisassociateIamInstanceProfileOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DisassociateIamInstanceProfileWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateIamInstanceProfileWithContext", _s...)
+}
+
 func (_m *MockEC2API) DisassociateRouteTableRequest(_param0 *ec2.DisassociateRouteTableInput) (*request.Request, *ec2.DisassociateRouteTableOutput) {
 	ret := _m.ctrl.Call(_m, "DisassociateRouteTableRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3704,6 +6446,22 @@ func (_mr *_MockEC2APIRecorder) DisassociateRouteTable(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateRouteTable", arg0)
 }
 
+func (_m *MockEC2API) DisassociateRouteTableWithContext(_param0 aws.Context, _param1 *ec2.DisassociateRouteTableInput, _param2 ...request.Option) (*ec2.DisassociateRouteTableOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisassociateRouteTableWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DisassociateRouteTableOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DisassociateRouteTableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateRouteTableWithContext", _s...)
+}
+
 func (_m *MockEC2API) DisassociateSubnetCidrBlockRequest(_param0 *ec2.DisassociateSubnetCidrBlockInput) (*request.Request, *ec2.DisassociateSubnetCidrBlockOutput) {
 	ret := _m.ctrl.Call(_m, "DisassociateSubnetCidrBlockRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3726,6 +6484,22 @@ func (_mr *_MockEC2APIRecorder) DisassociateSubnetCidrBlock(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateSubnetCidrBlock", arg0)
 }
 
+func (_m *MockEC2API) DisassociateSubnetCidrBlockWithContext(_param0 aws.Context, _param1 *ec2.DisassociateSubnetCidrBlockInput, _param2 ...request.Option) (*ec2.DisassociateSubnetCidrBlockOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisassociateSubnetCidrBlockWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DisassociateSubnetCidrBlockOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DisassociateSubnetCidrBlockWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateSubnetCidrBlockWithContext", _s...)
+}
+
 func (_m *MockEC2API) DisassociateVpcCidrBlockRequest(_param0 *ec2.DisassociateVpcCidrBlockInput) (*request.Request, *ec2.DisassociateVpcCidrBlockOutput) {
 	ret := _m.ctrl.Call(_m, "DisassociateVpcCidrBlockRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3748,6 +6522,22 @@ func (_mr *_MockEC2APIRecorder) DisassociateVpcCidrBlock(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateVpcCidrBlock", arg0)
 }
 
+func (_m *MockEC2API) DisassociateVpcCidrBlockWithContext(_param0 aws.Context, _param1 *ec2.DisassociateVpcCidrBlockInput, _param2 ...request.Option) (*ec2.DisassociateVpcCidrBlockOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DisassociateVpcCidrBlockWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.DisassociateVpcCidrBlockOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) DisassociateVpcCidrBlockWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisassociateVpcCidrBlockWithContext", _s...)
+}
+
 func (_m *MockEC2API) EnableVgwRoutePropagationRequest(_param0 *ec2.EnableVgwRoutePropagationInput) (*request.Request, *ec2.EnableVgwRoutePropagationOutput) {
 	ret := _m.ctrl.Call(_m, "EnableVgwRoutePropagationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3770,6 +6560,22 @@ func (_mr *_MockEC2APIRecorder) EnableVgwRoutePropagation(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableVgwRoutePropagation", arg0)
 }
 
+func (_m *MockEC2API) EnableVgwRoutePropagationWithContext(_param0 aws.Context, _param1 *ec2.EnableVgwRoutePropagationInput, _param2 ...request.Option) (*ec2.EnableVgwRoutePropagationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "EnableVgwRoutePropagationWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.EnableVgwRoutePropagationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) EnableVgwRoutePropagationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableVgwRoutePropagationWithContext", _s...)
+}
+
 func (_m *MockEC2API) EnableVolumeIORequest(_param0 *ec2.EnableVolumeIOInput) (*request.Request, *ec2.EnableVolumeIOOutput) {
 	ret := _m.ctrl.Call(_m, "EnableVolumeIORequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3792,6 +6598,22 @@ func (_mr *_MockEC2APIRecorder) EnableVolumeIO(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableVolumeIO", arg0)
 }
 
+func (_m *MockEC2API) EnableVolumeIOWithContext(_param0 aws.Context, _param1 *ec2.EnableVolumeIOInput, _param2 ...request.Option) (*ec2.EnableVolumeIOOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "EnableVolumeIOWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.EnableVolumeIOOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) EnableVolumeIOWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableVolumeIOWithContext", _s...)
+}
+
 func (_m *MockEC2API) EnableVpcClassicLinkRequest(_param0 *ec2.EnableVpcClassicLinkInput) (*request.Request, *ec2.EnableVpcClassicLinkOutput) {
 	ret := _m.ctrl.Call(_m, "EnableVpcClassicLinkRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3814,6 +6636,22 @@ func (_mr *_MockEC2APIRecorder) EnableVpcClassicLink(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableVpcClassicLink", arg0)
 }
 
+func (_m *MockEC2API) EnableVpcClassicLinkWithContext(_param0 aws.Context, _param1 *ec2.EnableVpcClassicLinkInput, _param2 ...request.Option) (*ec2.EnableVpcClassicLinkOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "EnableVpcClassicLinkWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.EnableVpcClassicLinkOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) EnableVpcClassicLinkWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableVpcClassicLinkWithContext", _s...)
+}
+
 func (_m *MockEC2API) EnableVpcClassicLinkDnsSupportRequest(_param0 *ec2.EnableVpcClassicLinkDnsSupportInput) (*request.Request, *ec2.EnableVpcClassicLinkDnsSupportOutput) {
 	ret := _m.ctrl.Call(_m, "EnableVpcClassicLinkDnsSupportRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3836,6 +6674,22 @@ func (_mr *_MockEC2APIRecorder) EnableVpcClassicLinkDnsSupport(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableVpcClassicLinkDnsSupport", arg0)
 }
 
+func (_m *MockEC2API) EnableVpcClassicLinkDnsSupportWithContext(_param0 aws.Context, _param1 *ec2.EnableVpcClassicLinkDnsSupportInput, _param2 ...request.Option) (*ec2.EnableVpcClassicLinkDnsSupportOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "EnableVpcClassicLinkDnsSupportWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.EnableVpcClassicLinkDnsSupportOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) EnableVpcClassicLinkDnsSupportWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EnableVpcClassicLinkDnsSupportWithContext", _s...)
+}
+
 func (_m *MockEC2API) GetConsoleOutputRequest(_param0 *ec2.GetConsoleOutputInput) (*request.Request, *ec2.GetConsoleOutputOutput) {
 	ret := _m.ctrl.Call(_m, "GetConsoleOutputRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3858,6 +6712,22 @@ func (_mr *_MockEC2APIRecorder) GetConsoleOutput(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetConsoleOutput", arg0)
 }
 
+func (_m *MockEC2API) GetConsoleOutputWithContext(_param0 aws.Context, _param1 *ec2.GetConsoleOutputInput, _param2 ...request.Option) (*ec2.GetConsoleOutputOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetConsoleOutputWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.GetConsoleOutputOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) GetConsoleOutputWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetConsoleOutputWithContext", _s...)
+}
+
 func (_m *MockEC2API) GetConsoleScreenshotRequest(_param0 *ec2.GetConsoleScreenshotInput) (*request.Request, *ec2.GetConsoleScreenshotOutput) {
 	ret := _m.ctrl.Call(_m, "GetConsoleScreenshotRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3880,11 +6750,27 @@ func (_mr *_MockEC2APIRecorder) GetConsoleScreenshot(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetConsoleScreenshot", arg0)
 }
 
-func (_m *MockEC2API) GetHostReservationPurchasePreviewRequest(_param0 *ec2.GetHostReservationPurchasePreviewInput) (*request.Request, *ec2.GetHostReservationPurchasePreviewOutput) {
-	ret := _m.ctrl.Call(_m, "GetHostReservationPurchasePreviewRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*ec2.GetHostReservationPurchasePreviewOutput)
-	return ret0, ret1
+func (_m *MockEC2API) GetConsoleScreenshotWithContext(_param0 aws.Context, _param1 *ec2.GetConsoleScreenshotInput, _param2 ...request.Option) (*ec2.GetConsoleScreenshotOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetConsoleScreenshotWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.GetConsoleScreenshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) GetConsoleScreenshotWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetConsoleScreenshotWithContext", _s...)
+}
+
+func (_m *MockEC2API) GetHostReservationPurchasePreviewRequest(_param0 *ec2.GetHostReservationPurchasePreviewInput) (*request.Request, *ec2.GetHostReservationPurchasePreviewOutput) {
+	ret := _m.ctrl.Call(_m, "GetHostReservationPurchasePreviewRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ec2.GetHostReservationPurchasePreviewOutput)
+	return ret0, ret1
 }
 
 func (_mr *_MockEC2APIRecorder) GetHostReservationPurchasePreviewRequest(arg0 interface{}) *gomock.Call {
@@ -3902,6 +6788,22 @@ func (_mr *_MockEC2APIRecorder) GetHostReservationPurchasePreview(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetHostReservationPurchasePreview", arg0)
 }
 
+func (_m *MockEC2API) GetHostReservationPurchasePreviewWithContext(_param0 aws.Context, _param1 *ec2.GetHostReservationPurchasePreviewInput, _param2 ...request.Option) (*ec2.GetHostReservationPurchasePreviewOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetHostReservationPurchasePreviewWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.GetHostReservationPurchasePreviewOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) GetHostReservationPurchasePreviewWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetHostReservationPurchasePreviewWithContext", _s...)
+}
+
 func (_m *MockEC2API) GetPasswordDataRequest(_param0 *ec2.GetPasswordDataInput) (*request.Request, *ec2.GetPasswordDataOutput) {
 	ret := _m.ctrl.Call(_m, "GetPasswordDataRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3924,6 +6826,22 @@ func (_mr *_MockEC2APIRecorder) GetPasswordData(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetPasswordData", arg0)
 }
 
+func (_m *MockEC2API) GetPasswordDataWithContext(_param0 aws.Context, _param1 *ec2.GetPasswordDataInput, _param2 ...request.Option) (*ec2.GetPasswordDataOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetPasswordDataWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.GetPasswordDataOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) GetPasswordDataWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetPasswordDataWithContext", _s...)
+}
+
 func (_m *MockEC2API) GetReservedInstancesExchangeQuoteRequest(_param0 *ec2.GetReservedInstancesExchangeQuoteInput) (*request.Request, *ec2.GetReservedInstancesExchangeQuoteOutput) {
 	ret := _m.ctrl.Call(_m, "GetReservedInstancesExchangeQuoteRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3946,6 +6864,22 @@ func (_mr *_MockEC2APIRecorder) GetReservedInstancesExchangeQuote(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetReservedInstancesExchangeQuote", arg0)
 }
 
+func (_m *MockEC2API) GetReservedInstancesExchangeQuoteWithContext(_param0 aws.Context, _param1 *ec2.GetReservedInstancesExchangeQuoteInput, _param2 ...request.Option) (*ec2.GetReservedInstancesExchangeQuoteOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetReservedInstancesExchangeQuoteWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.GetReservedInstancesExchangeQuoteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) GetReservedInstancesExchangeQuoteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetReservedInstancesExchangeQuoteWithContext", _s...)
+}
+
 func (_m *MockEC2API) ImportImageRequest(_param0 *ec2.ImportImageInput) (*request.Request, *ec2.ImportImageOutput) {
 	ret := _m.ctrl.Call(_m, "ImportImageRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3968,6 +6902,22 @@ func (_mr *_MockEC2APIRecorder) ImportImage(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportImage", arg0)
 }
 
+func (_m *MockEC2API) ImportImageWithContext(_param0 aws.Context, _param1 *ec2.ImportImageInput, _param2 ...request.Option) (*ec2.ImportImageOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ImportImageWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ImportImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ImportImageWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportImageWithContext", _s...)
+}
+
 func (_m *MockEC2API) ImportInstanceRequest(_param0 *ec2.ImportInstanceInput) (*request.Request, *ec2.ImportInstanceOutput) {
 	ret := _m.ctrl.Call(_m, "ImportInstanceRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -3990,6 +6940,22 @@ func (_mr *_MockEC2APIRecorder) ImportInstance(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportInstance", arg0)
 }
 
+func (_m *MockEC2API) ImportInstanceWithContext(_param0 aws.Context, _param1 *ec2.ImportInstanceInput, _param2 ...request.Option) (*ec2.ImportInstanceOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ImportInstanceWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ImportInstanceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ImportInstanceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportInstanceWithContext", _s...)
+}
+
 func (_m *MockEC2API) ImportKeyPairRequest(_param0 *ec2.ImportKeyPairInput) (*request.Request, *ec2.ImportKeyPairOutput) {
 	ret := _m.ctrl.Call(_m, "ImportKeyPairRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4012,6 +6978,22 @@ func (_mr *_MockEC2APIRecorder) ImportKeyPair(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportKeyPair", arg0)
 }
 
+func (_m *MockEC2API) ImportKeyPairWithContext(_param0 aws.Context, _param1 *ec2.ImportKeyPairInput, _param2 ...request.Option) (*ec2.ImportKeyPairOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ImportKeyPairWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ImportKeyPairOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ImportKeyPairWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportKeyPairWithContext", _s...)
+}
+
 func (_m *MockEC2API) ImportSnapshotRequest(_param0 *ec2.ImportSnapshotInput) (*request.Request, *ec2.ImportSnapshotOutput) {
 	ret := _m.ctrl.Call(_m, "ImportSnapshotRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4034,6 +7016,22 @@ func (_mr *_MockEC2APIRecorder) ImportSnapshot(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportSnapshot", arg0)
 }
 
+func (_m *MockEC2API) ImportSnapshotWithContext(_param0 aws.Context, _param1 *ec2.ImportSnapshotInput, _param2 ...request.Option) (*ec2.ImportSnapshotOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ImportSnapshotWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ImportSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ImportSnapshotWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportSnapshotWithContext", _s...)
+}
+
 func (_m *MockEC2API) ImportVolumeRequest(_param0 *ec2.ImportVolumeInput) (*request.Request, *ec2.ImportVolumeOutput) {
 	ret := _m.ctrl.Call(_m, "ImportVolumeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4056,6 +7054,22 @@ func (_mr *_MockEC2APIRecorder) ImportVolume(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportVolume", arg0)
 }
 
+func (_m *MockEC2API) ImportVolumeWithContext(_param0 aws.Context, _param1 *ec2.ImportVolumeInput, _param2 ...request.Option) (*ec2.ImportVolumeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ImportVolumeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ImportVolumeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ImportVolumeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportVolumeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyHostsRequest(_param0 *ec2.ModifyHostsInput) (*request.Request, *ec2.ModifyHostsOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyHostsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4078,6 +7092,22 @@ func (_mr *_MockEC2APIRecorder) ModifyHosts(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyHosts", arg0)
 }
 
+func (_m *MockEC2API) ModifyHostsWithContext(_param0 aws.Context, _param1 *ec2.ModifyHostsInput, _param2 ...request.Option) (*ec2.ModifyHostsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyHostsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyHostsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyHostsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyHostsWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyIdFormatRequest(_param0 *ec2.ModifyIdFormatInput) (*request.Request, *ec2.ModifyIdFormatOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyIdFormatRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4100,6 +7130,22 @@ func (_mr *_MockEC2APIRecorder) ModifyIdFormat(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyIdFormat", arg0)
 }
 
+func (_m *MockEC2API) ModifyIdFormatWithContext(_param0 aws.Context, _param1 *ec2.ModifyIdFormatInput, _param2 ...request.Option) (*ec2.ModifyIdFormatOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyIdFormatWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyIdFormatOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyIdFormatWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyIdFormatWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyIdentityIdFormatRequest(_param0 *ec2.ModifyIdentityIdFormatInput) (*request.Request, *ec2.ModifyIdentityIdFormatOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyIdentityIdFormatRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4122,6 +7168,22 @@ func (_mr *_MockEC2APIRecorder) ModifyIdentityIdFormat(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyIdentityIdFormat", arg0)
 }
 
+func (_m *MockEC2API) ModifyIdentityIdFormatWithContext(_param0 aws.Context, _param1 *ec2.ModifyIdentityIdFormatInput, _param2 ...request.Option) (*ec2.ModifyIdentityIdFormatOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyIdentityIdFormatWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyIdentityIdFormatOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyIdentityIdFormatWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyIdentityIdFormatWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyImageAttributeRequest(_param0 *ec2.ModifyImageAttributeInput) (*request.Request, *ec2.ModifyImageAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyImageAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4144,6 +7206,22 @@ func (_mr *_MockEC2APIRecorder) ModifyImageAttribute(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyImageAttribute", arg0)
 }
 
+func (_m *MockEC2API) ModifyImageAttributeWithContext(_param0 aws.Context, _param1 *ec2.ModifyImageAttributeInput, _param2 ...request.Option) (*ec2.ModifyImageAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyImageAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyImageAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyImageAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyImageAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyInstanceAttributeRequest(_param0 *ec2.ModifyInstanceAttributeInput) (*request.Request, *ec2.ModifyInstanceAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyInstanceAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4166,6 +7244,22 @@ func (_mr *_MockEC2APIRecorder) ModifyInstanceAttribute(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyInstanceAttribute", arg0)
 }
 
+func (_m *MockEC2API) ModifyInstanceAttributeWithContext(_param0 aws.Context, _param1 *ec2.ModifyInstanceAttributeInput, _param2 ...request.Option) (*ec2.ModifyInstanceAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyInstanceAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyInstanceAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyInstanceAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyInstanceAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyInstancePlacementRequest(_param0 *ec2.ModifyInstancePlacementInput) (*request.Request, *ec2.ModifyInstancePlacementOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyInstancePlacementRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4188,6 +7282,22 @@ func (_mr *_MockEC2APIRecorder) ModifyInstancePlacement(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyInstancePlacement", arg0)
 }
 
+func (_m *MockEC2API) ModifyInstancePlacementWithContext(_param0 aws.Context, _param1 *ec2.ModifyInstancePlacementInput, _param2 ...request.Option) (*ec2.ModifyInstancePlacementOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyInstancePlacementWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyInstancePlacementOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyInstancePlacementWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyInstancePlacementWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyNetworkInterfaceAttributeRequest(_param0 *ec2.ModifyNetworkInterfaceAttributeInput) (*request.Request, *ec2.ModifyNetworkInterfaceAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyNetworkInterfaceAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4210,6 +7320,22 @@ func (_mr *_MockEC2APIRecorder) ModifyNetworkInterfaceAttribute(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyNetworkInterfaceAttribute", arg0)
 }
 
+func (_m *MockEC2API) ModifyNetworkInterfaceAttributeWithContext(_param0 aws.Context, _param1 *ec2.ModifyNetworkInterfaceAttributeInput, _param2 ...request.Option) (*ec2.ModifyNetworkInterfaceAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyNetworkInterfaceAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyNetworkInterfaceAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyNetworkInterfaceAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyNetworkInterfaceAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyReservedInstancesRequest(_param0 *ec2.ModifyReservedInstancesInput) (*request.Request, *ec2.ModifyReservedInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyReservedInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4232,6 +7358,22 @@ func (_mr *_MockEC2APIRecorder) ModifyReservedInstances(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyReservedInstances", arg0)
 }
 
+func (_m *MockEC2API) ModifyReservedInstancesWithContext(_param0 aws.Context, _param1 *ec2.ModifyReservedInstancesInput, _param2 ...request.Option) (*ec2.ModifyReservedInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyReservedInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyReservedInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyReservedInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyReservedInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifySnapshotAttributeRequest(_param0 *ec2.ModifySnapshotAttributeInput) (*request.Request, *ec2.ModifySnapshotAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ModifySnapshotAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4254,6 +7396,22 @@ func (_mr *_MockEC2APIRecorder) ModifySnapshotAttribute(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifySnapshotAttribute", arg0)
 }
 
+func (_m *MockEC2API) ModifySnapshotAttributeWithContext(_param0 aws.Context, _param1 *ec2.ModifySnapshotAttributeInput, _param2 ...request.Option) (*ec2.ModifySnapshotAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifySnapshotAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifySnapshotAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifySnapshotAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifySnapshotAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifySpotFleetRequestRequest(_param0 *ec2.ModifySpotFleetRequestInput) (*request.Request, *ec2.ModifySpotFleetRequestOutput) {
 	ret := _m.ctrl.Call(_m, "ModifySpotFleetRequestRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4298,6 +7456,22 @@ func (_mr *_MockEC2APIRecorder) ModifySubnetAttribute(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifySubnetAttribute", arg0)
 }
 
+func (_m *MockEC2API) ModifySubnetAttributeWithContext(_param0 aws.Context, _param1 *ec2.ModifySubnetAttributeInput, _param2 ...request.Option) (*ec2.ModifySubnetAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifySubnetAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifySubnetAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifySubnetAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifySubnetAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyVolumeRequest(_param0 *ec2.ModifyVolumeInput) (*request.Request, *ec2.ModifyVolumeOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyVolumeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4320,6 +7494,22 @@ func (_mr *_MockEC2APIRecorder) ModifyVolume(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVolume", arg0)
 }
 
+func (_m *MockEC2API) ModifyVolumeWithContext(_param0 aws.Context, _param1 *ec2.ModifyVolumeInput, _param2 ...request.Option) (*ec2.ModifyVolumeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyVolumeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyVolumeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyVolumeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVolumeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyVolumeAttributeRequest(_param0 *ec2.ModifyVolumeAttributeInput) (*request.Request, *ec2.ModifyVolumeAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyVolumeAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4342,6 +7532,22 @@ func (_mr *_MockEC2APIRecorder) ModifyVolumeAttribute(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVolumeAttribute", arg0)
 }
 
+func (_m *MockEC2API) ModifyVolumeAttributeWithContext(_param0 aws.Context, _param1 *ec2.ModifyVolumeAttributeInput, _param2 ...request.Option) (*ec2.ModifyVolumeAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyVolumeAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyVolumeAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyVolumeAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVolumeAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyVpcAttributeRequest(_param0 *ec2.ModifyVpcAttributeInput) (*request.Request, *ec2.ModifyVpcAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyVpcAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4364,6 +7570,22 @@ func (_mr *_MockEC2APIRecorder) ModifyVpcAttribute(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVpcAttribute", arg0)
 }
 
+func (_m *MockEC2API) ModifyVpcAttributeWithContext(_param0 aws.Context, _param1 *ec2.ModifyVpcAttributeInput, _param2 ...request.Option) (*ec2.ModifyVpcAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyVpcAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyVpcAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyVpcAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVpcAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyVpcEndpointRequest(_param0 *ec2.ModifyVpcEndpointInput) (*request.Request, *ec2.ModifyVpcEndpointOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyVpcEndpointRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4386,6 +7608,22 @@ func (_mr *_MockEC2APIRecorder) ModifyVpcEndpoint(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVpcEndpoint", arg0)
 }
 
+func (_m *MockEC2API) ModifyVpcEndpointWithContext(_param0 aws.Context, _param1 *ec2.ModifyVpcEndpointInput, _param2 ...request.Option) (*ec2.ModifyVpcEndpointOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyVpcEndpointWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyVpcEndpointOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyVpcEndpointWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVpcEndpointWithContext", _s...)
+}
+
 func (_m *MockEC2API) ModifyVpcPeeringConnectionOptionsRequest(_param0 *ec2.ModifyVpcPeeringConnectionOptionsInput) (*request.Request, *ec2.ModifyVpcPeeringConnectionOptionsOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyVpcPeeringConnectionOptionsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4408,6 +7646,22 @@ func (_mr *_MockEC2APIRecorder) ModifyVpcPeeringConnectionOptions(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVpcPeeringConnectionOptions", arg0)
 }
 
+func (_m *MockEC2API) ModifyVpcPeeringConnectionOptionsWithContext(_param0 aws.Context, _param1 *ec2.ModifyVpcPeeringConnectionOptionsInput, _param2 ...request.Option) (*ec2.ModifyVpcPeeringConnectionOptionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyVpcPeeringConnectionOptionsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ModifyVpcPeeringConnectionOptionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ModifyVpcPeeringConnectionOptionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyVpcPeeringConnectionOptionsWithContext", _s...)
+}
+
 func (_m *MockEC2API) MonitorInstancesRequest(_param0 *ec2.MonitorInstancesInput) (*request.Request, *ec2.MonitorInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "MonitorInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4430,6 +7684,22 @@ func (_mr *_MockEC2APIRecorder) MonitorInstances(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MonitorInstances", arg0)
 }
 
+func (_m *MockEC2API) MonitorInstancesWithContext(_param0 aws.Context, _param1 *ec2.MonitorInstancesInput, _param2 ...request.Option) (*ec2.MonitorInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "MonitorInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.MonitorInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) MonitorInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MonitorInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) MoveAddressToVpcRequest(_param0 *ec2.MoveAddressToVpcInput) (*request.Request, *ec2.MoveAddressToVpcOutput) {
 	ret := _m.ctrl.Call(_m, "MoveAddressToVpcRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4452,6 +7722,22 @@ func (_mr *_MockEC2APIRecorder) MoveAddressToVpc(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MoveAddressToVpc", arg0)
 }
 
+func (_m *MockEC2API) MoveAddressToVpcWithContext(_param0 aws.Context, _param1 *ec2.MoveAddressToVpcInput, _param2 ...request.Option) (*ec2.MoveAddressToVpcOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "MoveAddressToVpcWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.MoveAddressToVpcOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) MoveAddressToVpcWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MoveAddressToVpcWithContext", _s...)
+}
+
 func (_m *MockEC2API) PurchaseHostReservationRequest(_param0 *ec2.PurchaseHostReservationInput) (*request.Request, *ec2.PurchaseHostReservationOutput) {
 	ret := _m.ctrl.Call(_m, "PurchaseHostReservationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4474,6 +7760,22 @@ func (_mr *_MockEC2APIRecorder) PurchaseHostReservation(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PurchaseHostReservation", arg0)
 }
 
+func (_m *MockEC2API) PurchaseHostReservationWithContext(_param0 aws.Context, _param1 *ec2.PurchaseHostReservationInput, _param2 ...request.Option) (*ec2.PurchaseHostReservationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PurchaseHostReservationWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.PurchaseHostReservationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) PurchaseHostReservationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PurchaseHostReservationWithContext", _s...)
+}
+
 func (_m *MockEC2API) PurchaseReservedInstancesOfferingRequest(_param0 *ec2.PurchaseReservedInstancesOfferingInput) (*request.Request, *ec2.PurchaseReservedInstancesOfferingOutput) {
 	ret := _m.ctrl.Call(_m, "PurchaseReservedInstancesOfferingRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4496,6 +7798,22 @@ func (_mr *_MockEC2APIRecorder) PurchaseReservedInstancesOffering(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PurchaseReservedInstancesOffering", arg0)
 }
 
+func (_m *MockEC2API) PurchaseReservedInstancesOfferingWithContext(_param0 aws.Context, _param1 *ec2.PurchaseReservedInstancesOfferingInput, _param2 ...request.Option) (*ec2.PurchaseReservedInstancesOfferingOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PurchaseReservedInstancesOfferingWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.PurchaseReservedInstancesOfferingOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) PurchaseReservedInstancesOfferingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PurchaseReservedInstancesOfferingWithContext", _s...)
+}
+
 func (_m *MockEC2API) PurchaseScheduledInstancesRequest(_param0 *ec2.PurchaseScheduledInstancesInput) (*request.Request, *ec2.PurchaseScheduledInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "PurchaseScheduledInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4518,6 +7836,22 @@ func (_mr *_MockEC2APIRecorder) PurchaseScheduledInstances(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "PurchaseScheduledInstances", arg0)
 }
 
+func (_m *MockEC2API) PurchaseScheduledInstancesWithContext(_param0 aws.Context, _param1 *ec2.PurchaseScheduledInstancesInput, _param2 ...request.Option) (*ec2.PurchaseScheduledInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "PurchaseScheduledInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.PurchaseScheduledInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) PurchaseScheduledInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PurchaseScheduledInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) RebootInstancesRequest(_param0 *ec2.RebootInstancesInput) (*request.Request, *ec2.RebootInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "RebootInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4540,6 +7874,22 @@ func (_mr *_MockEC2APIRecorder) RebootInstances(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RebootInstances", arg0)
 }
 
+func (_m *MockEC2API) RebootInstancesWithContext(_param0 aws.Context, _param1 *ec2.RebootInstancesInput, _param2 ...request.Option) (*ec2.RebootInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RebootInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.RebootInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RebootInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RebootInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) RegisterImageRequest(_param0 *ec2.RegisterImageInput) (*request.Request, *ec2.RegisterImageOutput) {
 	ret := _m.ctrl.Call(_m, "RegisterImageRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4562,6 +7912,22 @@ func (_mr *_MockEC2APIRecorder) RegisterImage(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterImage", arg0)
 }
 
+func (_m *MockEC2API) RegisterImageWithContext(_param0 aws.Context, _param1 *ec2.RegisterImageInput, _param2 ...request.Option) (*ec2.RegisterImageOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RegisterImageWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.RegisterImageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RegisterImageWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterImageWithContext", _s...)
+}
+
 func (_m *MockEC2API) RejectVpcPeeringConnectionRequest(_param0 *ec2.RejectVpcPeeringConnectionInput) (*request.Request, *ec2.RejectVpcPeeringConnectionOutput) {
 	ret := _m.ctrl.Call(_m, "RejectVpcPeeringConnectionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4584,6 +7950,22 @@ func (_mr *_MockEC2APIRecorder) RejectVpcPeeringConnection(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RejectVpcPeeringConnection", arg0)
 }
 
+func (_m *MockEC2API) RejectVpcPeeringConnectionWithContext(_param0 aws.Context, _param1 *ec2.RejectVpcPeeringConnectionInput, _param2 ...request.Option) (*ec2.RejectVpcPeeringConnectionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RejectVpcPeeringConnectionWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.RejectVpcPeeringConnectionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RejectVpcPeeringConnectionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RejectVpcPeeringConnectionWithContext", _s...)
+}
+
 func (_m *MockEC2API) ReleaseAddressRequest(_param0 *ec2.ReleaseAddressInput) (*request.Request, *ec2.ReleaseAddressOutput) {
 	ret := _m.ctrl.Call(_m, "ReleaseAddressRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4606,6 +7988,22 @@ func (_mr *_MockEC2APIRecorder) ReleaseAddress(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReleaseAddress", arg0)
 }
 
+func (_m *MockEC2API) ReleaseAddressWithContext(_param0 aws.Context, _param1 *ec2.ReleaseAddressInput, _param2 ...request.Option) (*ec2.ReleaseAddressOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ReleaseAddressWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ReleaseAddressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ReleaseAddressWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReleaseAddressWithContext", _s...)
+}
+
 func (_m *MockEC2API) ReleaseHostsRequest(_param0 *ec2.ReleaseHostsInput) (*request.Request, *ec2.ReleaseHostsOutput) {
 	ret := _m.ctrl.Call(_m, "ReleaseHostsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4628,6 +8026,22 @@ func (_mr *_MockEC2APIRecorder) ReleaseHosts(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReleaseHosts", arg0)
 }
 
+func (_m *MockEC2API) ReleaseHostsWithContext(_param0 aws.Context, _param1 *ec2.ReleaseHostsInput, _param2 ...request.Option) (*ec2.ReleaseHostsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ReleaseHostsWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ReleaseHostsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ReleaseHostsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReleaseHostsWithContext", _s...)
+}
+
 func (_m *MockEC2API) ReplaceIamInstanceProfileAssociationRequest(_param0 *ec2.ReplaceIamInstanceProfileAssociationInput) (*request.Request, *ec2.ReplaceIamInstanceProfileAssociationOutput) {
 	ret := _m.ctrl.Call(_m, "ReplaceIamInstanceProfileAssociationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4650,6 +8064,22 @@ func (_mr *_MockEC2APIRecorder) ReplaceIamInstanceProfileAssociation(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceIamInstanceProfileAssociation", arg0)
 }
 
+func (_m *MockEC2API) ReplaceIamInstanceProfileAssociationWithContext(_param0 aws.Context, _param1 *ec2.ReplaceIamInstanceProfileAssociationInput, _param2 ...request.Option) (*ec2.ReplaceIamInstanceProfileAssociationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ReplaceIamInstanceProfileAssociationWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ReplaceIamInstanceProfileAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ReplaceIamInstanceProfileAssociationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceIamInstanceProfileAssociationWithContext", _s...)
+}
+
 func (_m *MockEC2API) ReplaceNetworkAclAssociationRequest(_param0 *ec2.ReplaceNetworkAclAssociationInput) (*request.Request, *ec2.ReplaceNetworkAclAssociationOutput) {
 	ret := _m.ctrl.Call(_m, "ReplaceNetworkAclAssociationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4672,10 +8102,26 @@ func (_mr *_MockEC2APIRecorder) ReplaceNetworkAclAssociation(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceNetworkAclAssociation", arg0)
 }
 
-func (_m *MockEC2API) ReplaceNetworkAclEntryRequest(_param0 *ec2.ReplaceNetworkAclEntryInput) (*request.Request, *ec2.ReplaceNetworkAclEntryOutput) {
-	ret := _m.ctrl.Call(_m, "ReplaceNetworkAclEntryRequest", _param0)
-	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*ec2.ReplaceNetworkAclEntryOutput)
+func (_m *MockEC2API) ReplaceNetworkAclAssociationWithContext(_param0 aws.Context, _param1 *ec2.ReplaceNetworkAclAssociationInput, _param2 ...request.Option) (*ec2.ReplaceNetworkAclAssociationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ReplaceNetworkAclAssociationWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ReplaceNetworkAclAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ReplaceNetworkAclAssociationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceNetworkAclAssociationWithContext", _s...)
+}
+
+func (_m *MockEC2API) ReplaceNetworkAclEntryRequest(_param0 *ec2.ReplaceNetworkAclEntryInput) (*request.Request, *ec2.ReplaceNetworkAclEntryOutput) {
+	ret := _m.ctrl.Call(_m, "ReplaceNetworkAclEntryRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*ec2.ReplaceNetworkAclEntryOutput)
 	return ret0, ret1
 }
 
@@ -4694,6 +8140,22 @@ func (_mr *_MockEC2APIRecorder) ReplaceNetworkAclEntry(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceNetworkAclEntry", arg0)
 }
 
+func (_m *MockEC2API) ReplaceNetworkAclEntryWithContext(_param0 aws.Context, _param1 *ec2.ReplaceNetworkAclEntryInput, _param2 ...request.Option) (*ec2.ReplaceNetworkAclEntryOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ReplaceNetworkAclEntryWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ReplaceNetworkAclEntryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ReplaceNetworkAclEntryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceNetworkAclEntryWithContext", _s...)
+}
+
 func (_m *MockEC2API) ReplaceRouteRequest(_param0 *ec2.ReplaceRouteInput) (*request.Request, *ec2.ReplaceRouteOutput) {
 	ret := _m.ctrl.Call(_m, "ReplaceRouteRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4716,6 +8178,22 @@ func (_mr *_MockEC2APIRecorder) ReplaceRoute(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceRoute", arg0)
 }
 
+func (_m *MockEC2API) ReplaceRouteWithContext(_param0 aws.Context, _param1 *ec2.ReplaceRouteInput, _param2 ...request.Option) (*ec2.ReplaceRouteOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ReplaceRouteWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ReplaceRouteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ReplaceRouteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceRouteWithContext", _s...)
+}
+
 func (_m *MockEC2API) ReplaceRouteTableAssociationRequest(_param0 *ec2.ReplaceRouteTableAssociationInput) (*request.Request, *ec2.ReplaceRouteTableAssociationOutput) {
 	ret := _m.ctrl.Call(_m, "ReplaceRouteTableAssociationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4738,6 +8216,22 @@ func (_mr *_MockEC2APIRecorder) ReplaceRouteTableAssociation(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceRouteTableAssociation", arg0)
 }
 
+func (_m *MockEC2API) ReplaceRouteTableAssociationWithContext(_param0 aws.Context, _param1 *ec2.ReplaceRouteTableAssociationInput, _param2 ...request.Option) (*ec2.ReplaceRouteTableAssociationOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ReplaceRouteTableAssociationWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ReplaceRouteTableAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ReplaceRouteTableAssociationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplaceRouteTableAssociationWithContext", _s...)
+}
+
 func (_m *MockEC2API) ReportInstanceStatusRequest(_param0 *ec2.ReportInstanceStatusInput) (*request.Request, *ec2.ReportInstanceStatusOutput) {
 	ret := _m.ctrl.Call(_m, "ReportInstanceStatusRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4760,6 +8254,22 @@ func (_mr *_MockEC2APIRecorder) ReportInstanceStatus(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReportInstanceStatus", arg0)
 }
 
+func (_m *MockEC2API) ReportInstanceStatusWithContext(_param0 aws.Context, _param1 *ec2.ReportInstanceStatusInput, _param2 ...request.Option) (*ec2.ReportInstanceStatusOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ReportInstanceStatusWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ReportInstanceStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ReportInstanceStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReportInstanceStatusWithContext", _s...)
+}
+
 func (_m *MockEC2API) RequestSpotFleetRequest(_param0 *ec2.RequestSpotFleetInput) (*request.Request, *ec2.RequestSpotFleetOutput) {
 	ret := _m.ctrl.Call(_m, "RequestSpotFleetRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4782,6 +8292,22 @@ func (_mr *_MockEC2APIRecorder) RequestSpotFleet(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RequestSpotFleet", arg0)
 }
 
+func (_m *MockEC2API) RequestSpotFleetWithContext(_param0 aws.Context, _param1 *ec2.RequestSpotFleetInput, _param2 ...request.Option) (*ec2.RequestSpotFleetOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RequestSpotFleetWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.RequestSpotFleetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RequestSpotFleetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RequestSpotFleetWithContext", _s...)
+}
+
 func (_m *MockEC2API) RequestSpotInstancesRequest(_param0 *ec2.RequestSpotInstancesInput) (*request.Request, *ec2.RequestSpotInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "RequestSpotInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4804,6 +8330,22 @@ func (_mr *_MockEC2APIRecorder) RequestSpotInstances(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RequestSpotInstances", arg0)
 }
 
+func (_m *MockEC2API) RequestSpotInstancesWithContext(_param0 aws.Context, _param1 *ec2.RequestSpotInstancesInput, _param2 ...request.Option) (*ec2.RequestSpotInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RequestSpotInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.RequestSpotInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RequestSpotInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RequestSpotInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) ResetImageAttributeRequest(_param0 *ec2.ResetImageAttributeInput) (*request.Request, *ec2.ResetImageAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ResetImageAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4826,6 +8368,22 @@ func (_mr *_MockEC2APIRecorder) ResetImageAttribute(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResetImageAttribute", arg0)
 }
 
+func (_m *MockEC2API) ResetImageAttributeWithContext(_param0 aws.Context, _param1 *ec2.ResetImageAttributeInput, _param2 ...request.Option) (*ec2.ResetImageAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ResetImageAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ResetImageAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ResetImageAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResetImageAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ResetInstanceAttributeRequest(_param0 *ec2.ResetInstanceAttributeInput) (*request.Request, *ec2.ResetInstanceAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ResetInstanceAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4848,6 +8406,22 @@ func (_mr *_MockEC2APIRecorder) ResetInstanceAttribute(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResetInstanceAttribute", arg0)
 }
 
+func (_m *MockEC2API) ResetInstanceAttributeWithContext(_param0 aws.Context, _param1 *ec2.ResetInstanceAttributeInput, _param2 ...request.Option) (*ec2.ResetInstanceAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ResetInstanceAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ResetInstanceAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ResetInstanceAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResetInstanceAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ResetNetworkInterfaceAttributeRequest(_param0 *ec2.ResetNetworkInterfaceAttributeInput) (*request.Request, *ec2.ResetNetworkInterfaceAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ResetNetworkInterfaceAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4870,6 +8444,22 @@ func (_mr *_MockEC2APIRecorder) ResetNetworkInterfaceAttribute(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResetNetworkInterfaceAttribute", arg0)
 }
 
+func (_m *MockEC2API) ResetNetworkInterfaceAttributeWithContext(_param0 aws.Context, _param1 *ec2.ResetNetworkInterfaceAttributeInput, _param2 ...request.Option) (*ec2.ResetNetworkInterfaceAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ResetNetworkInterfaceAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ResetNetworkInterfaceAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ResetNetworkInterfaceAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResetNetworkInterfaceAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) ResetSnapshotAttributeRequest(_param0 *ec2.ResetSnapshotAttributeInput) (*request.Request, *ec2.ResetSnapshotAttributeOutput) {
 	ret := _m.ctrl.Call(_m, "ResetSnapshotAttributeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4892,6 +8482,22 @@ func (_mr *_MockEC2APIRecorder) ResetSnapshotAttribute(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResetSnapshotAttribute", arg0)
 }
 
+func (_m *MockEC2API) ResetSnapshotAttributeWithContext(_param0 aws.Context, _param1 *ec2.ResetSnapshotAttributeInput, _param2 ...request.Option) (*ec2.ResetSnapshotAttributeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ResetSnapshotAttributeWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.ResetSnapshotAttributeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) ResetSnapshotAttributeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResetSnapshotAttributeWithContext", _s...)
+}
+
 func (_m *MockEC2API) RestoreAddressToClassicRequest(_param0 *ec2.RestoreAddressToClassicInput) (*request.Request, *ec2.RestoreAddressToClassicOutput) {
 	ret := _m.ctrl.Call(_m, "RestoreAddressToClassicRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4914,6 +8520,22 @@ func (_mr *_MockEC2APIRecorder) RestoreAddressToClassic(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RestoreAddressToClassic", arg0)
 }
 
+func (_m *MockEC2API) RestoreAddressToClassicWithContext(_param0 aws.Context, _param1 *ec2.RestoreAddressToClassicInput, _param2 ...request.Option) (*ec2.RestoreAddressToClassicOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RestoreAddressToClassicWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.RestoreAddressToClassicOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RestoreAddressToClassicWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RestoreAddressToClassicWithContext", _s...)
+}
+
 func (_m *MockEC2API) RevokeSecurityGroupEgressRequest(_param0 *ec2.RevokeSecurityGroupEgressInput) (*request.Request, *ec2.RevokeSecurityGroupEgressOutput) {
 	ret := _m.ctrl.Call(_m, "RevokeSecurityGroupEgressRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4936,6 +8558,22 @@ func (_mr *_MockEC2APIRecorder) RevokeSecurityGroupEgress(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RevokeSecurityGroupEgress", arg0)
 }
 
+func (_m *MockEC2API) RevokeSecurityGroupEgressWithContext(_param0 aws.Context, _param1 *ec2.RevokeSecurityGroupEgressInput, _param2 ...request.Option) (*ec2.RevokeSecurityGroupEgressOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RevokeSecurityGroupEgressWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.RevokeSecurityGroupEgressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RevokeSecurityGroupEgressWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RevokeSecurityGroupEgressWithContext", _s...)
+}
+
 func (_m *MockEC2API) RevokeSecurityGroupIngressRequest(_param0 *ec2.RevokeSecurityGroupIngressInput) (*request.Request, *ec2.RevokeSecurityGroupIngressOutput) {
 	ret := _m.ctrl.Call(_m, "RevokeSecurityGroupIngressRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4958,6 +8596,22 @@ func (_mr *_MockEC2APIRecorder) RevokeSecurityGroupIngress(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RevokeSecurityGroupIngress", arg0)
 }
 
+func (_m *MockEC2API) RevokeSecurityGroupIngressWithContext(_param0 aws.Context, _param1 *ec2.RevokeSecurityGroupIngressInput, _param2 ...request.Option) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RevokeSecurityGroupIngressWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.RevokeSecurityGroupIngressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RevokeSecurityGroupIngressWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RevokeSecurityGroupIngressWithContext", _s...)
+}
+
 func (_m *MockEC2API) RunInstancesRequest(_param0 *ec2.RunInstancesInput) (*request.Request, *ec2.Reservation) {
 	ret := _m.ctrl.Call(_m, "RunInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -4980,6 +8634,22 @@ func (_mr *_MockEC2APIRecorder) RunInstances(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RunInstances", arg0)
 }
 
+func (_m *MockEC2API) RunInstancesWithContext(_param0 aws.Context, _param1 *ec2.RunInstancesInput, _param2 ...request.Option) (*ec2.Reservation, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RunInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RunInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RunInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) RunScheduledInstancesRequest(_param0 *ec2.RunScheduledInstancesInput) (*request.Request, *ec2.RunScheduledInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "RunScheduledInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -5002,6 +8672,22 @@ func (_mr *_MockEC2APIRecorder) RunScheduledInstances(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RunScheduledInstances", arg0)
 }
 
+func (_m *MockEC2API) RunScheduledInstancesWithContext(_param0 aws.Context, _param1 *ec2.RunScheduledInstancesInput, _param2 ...request.Option) (*ec2.RunScheduledInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RunScheduledInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.RunScheduledInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) RunScheduledInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RunScheduledInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) StartInstancesRequest(_param0 *ec2.StartInstancesInput) (*request.Request, *ec2.StartInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "StartInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -5024,6 +8710,22 @@ func (_mr *_MockEC2APIRecorder) StartInstances(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "StartInstances", arg0)
 }
 
+func (_m *MockEC2API) StartInstancesWithContext(_param0 aws.Context, _param1 *ec2.StartInstancesInput, _param2 ...request.Option) (*ec2.StartInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "StartInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.StartInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) StartInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "StartInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) StopInstancesRequest(_param0 *ec2.StopInstancesInput) (*request.Request, *ec2.StopInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "StopInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -5046,6 +8748,22 @@ func (_mr *_MockEC2APIRecorder) StopInstances(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "StopInstances", arg0)
 }
 
+func (_m *MockEC2API) StopInstancesWithContext(_param0 aws.Context, _param1 *ec2.StopInstancesInput, _param2 ...request.Option) (*ec2.StopInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "StopInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.StopInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) StopInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "StopInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) TerminateInstancesRequest(_param0 *ec2.TerminateInstancesInput) (*request.Request, *ec2.TerminateInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "TerminateInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -5068,6 +8786,22 @@ func (_mr *_MockEC2APIRecorder) TerminateInstances(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "TerminateInstances", arg0)
 }
 
+func (_m *MockEC2API) TerminateInstancesWithContext(_param0 aws.Context, _param1 *ec2.TerminateInstancesInput, _param2 ...request.Option) (*ec2.TerminateInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "TerminateInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.TerminateInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) TerminateInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "TerminateInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) UnassignIpv6AddressesRequest(_param0 *ec2.UnassignIpv6AddressesInput) (*request.Request, *ec2.UnassignIpv6AddressesOutput) {
 	ret := _m.ctrl.Call(_m, "UnassignIpv6AddressesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -5090,6 +8824,22 @@ func (_mr *_MockEC2APIRecorder) UnassignIpv6Addresses(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "UnassignIpv6Addresses", arg0)
 }
 
+func (_m *MockEC2API) UnassignIpv6AddressesWithContext(_param0 aws.Context, _param1 *ec2.UnassignIpv6AddressesInput, _param2 ...request.Option) (*ec2.UnassignIpv6AddressesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "UnassignIpv6AddressesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.UnassignIpv6AddressesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) UnassignIpv6AddressesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UnassignIpv6AddressesWithContext", _s...)
+}
+
 func (_m *MockEC2API) UnassignPrivateIpAddressesRequest(_param0 *ec2.UnassignPrivateIpAddressesInput) (*request.Request, *ec2.UnassignPrivateIpAddressesOutput) {
 	ret := _m.ctrl.Call(_m, "UnassignPrivateIpAddressesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -5112,6 +8862,22 @@ func (_mr *_MockEC2APIRecorder) UnassignPrivateIpAddresses(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "UnassignPrivateIpAddresses", arg0)
 }
 
+func (_m *MockEC2API) UnassignPrivateIpAddressesWithContext(_param0 aws.Context, _param1 *ec2.UnassignPrivateIpAddressesInput, _param2 ...request.Option) (*ec2.UnassignPrivateIpAddressesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "UnassignPrivateIpAddressesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.UnassignPrivateIpAddressesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) UnassignPrivateIpAddressesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UnassignPrivateIpAddressesWithContext", _s...)
+}
+
 func (_m *MockEC2API) UnmonitorInstancesRequest(_param0 *ec2.UnmonitorInstancesInput) (*request.Request, *ec2.UnmonitorInstancesOutput) {
 	ret := _m.ctrl.Call(_m, "UnmonitorInstancesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -5134,6 +8900,22 @@ func (_mr *_MockEC2APIRecorder) UnmonitorInstances(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "UnmonitorInstances", arg0)
 }
 
+func (_m *MockEC2API) UnmonitorInstancesWithContext(_param0 aws.Context, _param1 *ec2.UnmonitorInstancesInput, _param2 ...request.Option) (*ec2.UnmonitorInstancesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "UnmonitorInstancesWithContext", _s...)
+	ret0, _ := ret[0].(*ec2.UnmonitorInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockEC2APIRecorder) UnmonitorInstancesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UnmonitorInstancesWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilBundleTaskComplete(_param0 *ec2.DescribeBundleTasksInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilBundleTaskComplete", _param0)
 	ret0, _ := ret[0].(error)
@@ -5144,6 +8926,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilBundleTaskComplete(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilBundleTaskComplete", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilBundleTaskCompleteWithContext(_param0 aws.Context, _param1 *ec2.DescribeBundleTasksInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilBundleTaskCompleteWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilBundleTaskCompleteWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilBundleTaskCompleteWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilConversionTaskCancelled(_param0 *ec2.DescribeConversionTasksInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilConversionTaskCancelled", _param0)
 	ret0, _ := ret[0].(error)
@@ -5154,6 +8951,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilConversionTaskCancelled(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilConversionTaskCancelled", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilConversionTaskCancelledWithContext(_param0 aws.Context, _param1 *ec2.DescribeConversionTasksInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilConversionTaskCancelledWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilConversionTaskCancelledWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilConversionTaskCancelledWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilConversionTaskCompleted(_param0 *ec2.DescribeConversionTasksInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilConversionTaskCompleted", _param0)
 	ret0, _ := ret[0].(error)
@@ -5164,6 +8976,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilConversionTaskCompleted(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilConversionTaskCompleted", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilConversionTaskCompletedWithContext(_param0 aws.Context, _param1 *ec2.DescribeConversionTasksInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilConversionTaskCompletedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilConversionTaskCompletedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilConversionTaskCompletedWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilConversionTaskDeleted(_param0 *ec2.DescribeConversionTasksInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilConversionTaskDeleted", _param0)
 	ret0, _ := ret[0].(error)
@@ -5174,6 +9001,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilConversionTaskDeleted(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilConversionTaskDeleted", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilConversionTaskDeletedWithContext(_param0 aws.Context, _param1 *ec2.DescribeConversionTasksInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilConversionTaskDeletedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilConversionTaskDeletedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilConversionTaskDeletedWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilCustomerGatewayAvailable(_param0 *ec2.DescribeCustomerGatewaysInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilCustomerGatewayAvailable", _param0)
 	ret0, _ := ret[0].(error)
@@ -5184,6 +9026,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilCustomerGatewayAvailable(arg0 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilCustomerGatewayAvailable", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilCustomerGatewayAvailableWithContext(_param0 aws.Context, _param1 *ec2.DescribeCustomerGatewaysInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilCustomerGatewayAvailableWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilCustomerGatewayAvailableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilCustomerGatewayAvailableWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilExportTaskCancelled(_param0 *ec2.DescribeExportTasksInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilExportTaskCancelled", _param0)
 	ret0, _ := ret[0].(error)
@@ -5194,6 +9051,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilExportTaskCancelled(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilExportTaskCancelled", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilExportTaskCancelledWithContext(_param0 aws.Context, _param1 *ec2.DescribeExportTasksInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilExportTaskCancelledWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilExportTaskCancelledWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilExportTaskCancelledWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilExportTaskCompleted(_param0 *ec2.DescribeExportTasksInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilExportTaskCompleted", _param0)
 	ret0, _ := ret[0].(error)
@@ -5204,6 +9076,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilExportTaskCompleted(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilExportTaskCompleted", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilExportTaskCompletedWithContext(_param0 aws.Context, _param1 *ec2.DescribeExportTasksInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilExportTaskCompletedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilExportTaskCompletedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilExportTaskCompletedWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilImageAvailable(_param0 *ec2.DescribeImagesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilImageAvailable", _param0)
 	ret0, _ := ret[0].(error)
@@ -5214,6 +9101,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilImageAvailable(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilImageAvailable", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilImageAvailableWithContext(_param0 aws.Context, _param1 *ec2.DescribeImagesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilImageAvailableWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilImageAvailableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilImageAvailableWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilImageExists(_param0 *ec2.DescribeImagesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilImageExists", _param0)
 	ret0, _ := ret[0].(error)
@@ -5224,6 +9126,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilImageExists(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilImageExists", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilImageExistsWithContext(_param0 aws.Context, _param1 *ec2.DescribeImagesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilImageExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilImageExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilImageExistsWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilInstanceExists(_param0 *ec2.DescribeInstancesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilInstanceExists", _param0)
 	ret0, _ := ret[0].(error)
@@ -5234,6 +9151,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilInstanceExists(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceExists", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilInstanceExistsWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstancesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilInstanceExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilInstanceExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceExistsWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilInstanceRunning(_param0 *ec2.DescribeInstancesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilInstanceRunning", _param0)
 	ret0, _ := ret[0].(error)
@@ -5244,6 +9176,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilInstanceRunning(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceRunning", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilInstanceRunningWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstancesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilInstanceRunningWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilInstanceRunningWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceRunningWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilInstanceStatusOk(_param0 *ec2.DescribeInstanceStatusInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilInstanceStatusOk", _param0)
 	ret0, _ := ret[0].(error)
@@ -5254,6 +9201,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilInstanceStatusOk(arg0 interface{}) *gom
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceStatusOk", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilInstanceStatusOkWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstanceStatusInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilInstanceStatusOkWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilInstanceStatusOkWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceStatusOkWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilInstanceStopped(_param0 *ec2.DescribeInstancesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilInstanceStopped", _param0)
 	ret0, _ := ret[0].(error)
@@ -5264,6 +9226,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilInstanceStopped(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceStopped", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilInstanceStoppedWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstancesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilInstanceStoppedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilInstanceStoppedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceStoppedWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilInstanceTerminated(_param0 *ec2.DescribeInstancesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilInstanceTerminated", _param0)
 	ret0, _ := ret[0].(error)
@@ -5274,6 +9251,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilInstanceTerminated(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceTerminated", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilInstanceTerminatedWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstancesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilInstanceTerminatedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilInstanceTerminatedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilInstanceTerminatedWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilKeyPairExists(_param0 *ec2.DescribeKeyPairsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilKeyPairExists", _param0)
 	ret0, _ := ret[0].(error)
@@ -5284,6 +9276,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilKeyPairExists(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilKeyPairExists", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilKeyPairExistsWithContext(_param0 aws.Context, _param1 *ec2.DescribeKeyPairsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilKeyPairExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilKeyPairExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilKeyPairExistsWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilNatGatewayAvailable(_param0 *ec2.DescribeNatGatewaysInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilNatGatewayAvailable", _param0)
 	ret0, _ := ret[0].(error)
@@ -5294,6 +9301,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilNatGatewayAvailable(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilNatGatewayAvailable", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilNatGatewayAvailableWithContext(_param0 aws.Context, _param1 *ec2.DescribeNatGatewaysInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilNatGatewayAvailableWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilNatGatewayAvailableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilNatGatewayAvailableWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilNetworkInterfaceAvailable(_param0 *ec2.DescribeNetworkInterfacesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilNetworkInterfaceAvailable", _param0)
 	ret0, _ := ret[0].(error)
@@ -5304,6 +9326,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilNetworkInterfaceAvailable(arg0 interfac
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilNetworkInterfaceAvailable", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilNetworkInterfaceAvailableWithContext(_param0 aws.Context, _param1 *ec2.DescribeNetworkInterfacesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilNetworkInterfaceAvailableWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilNetworkInterfaceAvailableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilNetworkInterfaceAvailableWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilPasswordDataAvailable(_param0 *ec2.GetPasswordDataInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilPasswordDataAvailable", _param0)
 	ret0, _ := ret[0].(error)
@@ -5314,6 +9351,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilPasswordDataAvailable(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilPasswordDataAvailable", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilPasswordDataAvailableWithContext(_param0 aws.Context, _param1 *ec2.GetPasswordDataInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilPasswordDataAvailableWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilPasswordDataAvailableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilPasswordDataAvailableWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilSnapshotCompleted(_param0 *ec2.DescribeSnapshotsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilSnapshotCompleted", _param0)
 	ret0, _ := ret[0].(error)
@@ -5324,6 +9376,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilSnapshotCompleted(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilSnapshotCompleted", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilSnapshotCompletedWithContext(_param0 aws.Context, _param1 *ec2.DescribeSnapshotsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilSnapshotCompletedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilSnapshotCompletedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilSnapshotCompletedWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilSpotInstanceRequestFulfilled(_param0 *ec2.DescribeSpotInstanceRequestsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilSpotInstanceRequestFulfilled", _param0)
 	ret0, _ := ret[0].(error)
@@ -5334,6 +9401,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilSpotInstanceRequestFulfilled(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilSpotInstanceRequestFulfilled", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilSpotInstanceRequestFulfilledWithContext(_param0 aws.Context, _param1 *ec2.DescribeSpotInstanceRequestsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilSpotInstanceRequestFulfilledWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilSpotInstanceRequestFulfilledWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilSpotInstanceRequestFulfilledWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilSubnetAvailable(_param0 *ec2.DescribeSubnetsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilSubnetAvailable", _param0)
 	ret0, _ := ret[0].(error)
@@ -5344,6 +9426,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilSubnetAvailable(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilSubnetAvailable", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilSubnetAvailableWithContext(_param0 aws.Context, _param1 *ec2.DescribeSubnetsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilSubnetAvailableWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilSubnetAvailableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilSubnetAvailableWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilSystemStatusOk(_param0 *ec2.DescribeInstanceStatusInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilSystemStatusOk", _param0)
 	ret0, _ := ret[0].(error)
@@ -5354,6 +9451,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilSystemStatusOk(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilSystemStatusOk", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilSystemStatusOkWithContext(_param0 aws.Context, _param1 *ec2.DescribeInstanceStatusInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilSystemStatusOkWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilSystemStatusOkWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilSystemStatusOkWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilVolumeAvailable(_param0 *ec2.DescribeVolumesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilVolumeAvailable", _param0)
 	ret0, _ := ret[0].(error)
@@ -5364,6 +9476,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilVolumeAvailable(arg0 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVolumeAvailable", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilVolumeAvailableWithContext(_param0 aws.Context, _param1 *ec2.DescribeVolumesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilVolumeAvailableWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilVolumeAvailableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVolumeAvailableWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilVolumeDeleted(_param0 *ec2.DescribeVolumesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilVolumeDeleted", _param0)
 	ret0, _ := ret[0].(error)
@@ -5374,6 +9501,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilVolumeDeleted(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVolumeDeleted", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilVolumeDeletedWithContext(_param0 aws.Context, _param1 *ec2.DescribeVolumesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilVolumeDeletedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilVolumeDeletedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVolumeDeletedWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilVolumeInUse(_param0 *ec2.DescribeVolumesInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilVolumeInUse", _param0)
 	ret0, _ := ret[0].(error)
@@ -5384,6 +9526,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilVolumeInUse(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVolumeInUse", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilVolumeInUseWithContext(_param0 aws.Context, _param1 *ec2.DescribeVolumesInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilVolumeInUseWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilVolumeInUseWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVolumeInUseWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilVpcAvailable(_param0 *ec2.DescribeVpcsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilVpcAvailable", _param0)
 	ret0, _ := ret[0].(error)
@@ -5394,6 +9551,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilVpcAvailable(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpcAvailable", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilVpcAvailableWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilVpcAvailableWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilVpcAvailableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpcAvailableWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilVpcExists(_param0 *ec2.DescribeVpcsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilVpcExists", _param0)
 	ret0, _ := ret[0].(error)
@@ -5404,6 +9576,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilVpcExists(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpcExists", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilVpcExistsWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilVpcExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilVpcExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpcExistsWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilVpcPeeringConnectionDeleted(_param0 *ec2.DescribeVpcPeeringConnectionsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilVpcPeeringConnectionDeleted", _param0)
 	ret0, _ := ret[0].(error)
@@ -5414,6 +9601,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilVpcPeeringConnectionDeleted(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpcPeeringConnectionDeleted", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilVpcPeeringConnectionDeletedWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcPeeringConnectionsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilVpcPeeringConnectionDeletedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilVpcPeeringConnectionDeletedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpcPeeringConnectionDeletedWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilVpcPeeringConnectionExists(_param0 *ec2.DescribeVpcPeeringConnectionsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilVpcPeeringConnectionExists", _param0)
 	ret0, _ := ret[0].(error)
@@ -5424,6 +9626,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilVpcPeeringConnectionExists(arg0 interfa
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpcPeeringConnectionExists", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilVpcPeeringConnectionExistsWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpcPeeringConnectionsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilVpcPeeringConnectionExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilVpcPeeringConnectionExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpcPeeringConnectionExistsWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilVpnConnectionAvailable(_param0 *ec2.DescribeVpnConnectionsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilVpnConnectionAvailable", _param0)
 	ret0, _ := ret[0].(error)
@@ -5434,6 +9651,21 @@ func (_mr *_MockEC2APIRecorder) WaitUntilVpnConnectionAvailable(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpnConnectionAvailable", arg0)
 }
 
+func (_m *MockEC2API) WaitUntilVpnConnectionAvailableWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpnConnectionsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilVpnConnectionAvailableWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilVpnConnectionAvailableWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpnConnectionAvailableWithContext", _s...)
+}
+
 func (_m *MockEC2API) WaitUntilVpnConnectionDeleted(_param0 *ec2.DescribeVpnConnectionsInput) error {
 	ret := _m.ctrl.Call(_m, "WaitUntilVpnConnectionDeleted", _param0)
 	ret0, _ := ret[0].(error)
@@ -5443,3 +9675,18 @@ func (_m *MockEC2API) WaitUntilVpnConnectionDeleted(_param0 *ec2.DescribeVpnConn
 func (_mr *_MockEC2APIRecorder) WaitUntilVpnConnectionDeleted(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpnConnectionDeleted", arg0)
 }
+
+func (_m *MockEC2API) WaitUntilVpnConnectionDeletedWithContext(_param0 aws.Context, _param1 *ec2.DescribeVpnConnectionsInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilVpnConnectionDeletedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockEC2APIRecorder) WaitUntilVpnConnectionDeletedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "WaitUntilVpnConnectionDeletedWithContext", _s...)
+}
@@ -4,6 +4,7 @@
 package mock
 
 import (
+	aws "github.com/aws/aws-sdk-go/aws"
 	request "github.com/aws/aws-sdk-go/aws/request"
 	elbv2 "github.com/aws/aws-sdk-go/service/elbv2"
 	gomock "github.com/golang/mock/gomock"
@@ -52,6 +53,22 @@ func (_mr *_MockELBV2APIRecorder) AddTags(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddTags", arg0)
 }
 
+func (_m *MockELBV2API) AddTagsWithContext(_param0 aws.Context, _param1 *elbv2.AddTagsInput, _param2 ...request.Option) (*elbv2.AddTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AddTagsWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.AddTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) AddTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddTagsWithContext", _s...)
+}
+
 func (_m *MockELBV2API) CreateListenerRequest(_param0 *elbv2.CreateListenerInput) (*request.Request, *elbv2.CreateListenerOutput) {
 	ret := _m.ctrl.Call(_m, "CreateListenerRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -74,6 +91,22 @@ func (_mr *_MockELBV2APIRecorder) CreateListener(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateListener", arg0)
 }
 
+func (_m *MockELBV2API) CreateListenerWithContext(_param0 aws.Context, _param1 *elbv2.CreateListenerInput, _param2 ...request.Option) (*elbv2.CreateListenerOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateListenerWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.CreateListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) CreateListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateListenerWithContext", _s...)
+}
+
 func (_m *MockELBV2API) CreateLoadBalancerRequest(_param0 *elbv2.CreateLoadBalancerInput) (*request.Request, *elbv2.CreateLoadBalancerOutput) {
 	ret := _m.ctrl.Call(_m, "CreateLoadBalancerRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -96,6 +129,22 @@ func (_mr *_MockELBV2APIRecorder) CreateLoadBalancer(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateLoadBalancer", arg0)
 }
 
+func (_m *MockELBV2API) CreateLoadBalancerWithContext(_param0 aws.Context, _param1 *elbv2.CreateLoadBalancerInput, _param2 ...request.Option) (*elbv2.CreateLoadBalancerOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateLoadBalancerWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.CreateLoadBalancerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) CreateLoadBalancerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateLoadBalancerWithContext", _s...)
+}
+
 func (_m *MockELBV2API) CreateRuleRequest(_param0 *elbv2.CreateRuleInput) (*request.Request, *elbv2.CreateRuleOutput) {
 	ret := _m.ctrl.Call(_m, "CreateRuleRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -118,6 +167,22 @@ func (_mr *_MockELBV2APIRecorder) CreateRule(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateRule", arg0)
 }
 
+func (_m *MockELBV2API) CreateRuleWithContext(_param0 aws.Context, _param1 *elbv2.CreateRuleInput, _param2 ...request.Option) (*elbv2.CreateRuleOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateRuleWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.CreateRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) CreateRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateRuleWithContext", _s...)
+}
+
 func (_m *MockELBV2API) CreateTargetGroupRequest(_param0 *elbv2.CreateTargetGroupInput) (*request.Request, *elbv2.CreateTargetGroupOutput) {
 	ret := _m.ctrl.Call(_m, "CreateTargetGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -140,6 +205,22 @@ func (_mr *_MockELBV2APIRecorder) CreateTargetGroup(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateTargetGroup", arg0)
 }
 
+func (_m *MockELBV2API) CreateTargetGroupWithContext(_param0 aws.Context, _param1 *elbv2.CreateTargetGroupInput, _param2 ...request.Option) (*elbv2.CreateTargetGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateTargetGroupWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.CreateTargetGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) CreateTargetGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateTargetGroupWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DeleteListenerRequest(_param0 *elbv2.DeleteListenerInput) (*request.Request, *elbv2.DeleteListenerOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteListenerRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -162,6 +243,22 @@ func (_mr *_MockELBV2APIRecorder) DeleteListener(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteListener", arg0)
 }
 
+func (_m *MockELBV2API) DeleteListenerWithContext(_param0 aws.Context, _param1 *elbv2.DeleteListenerInput, _param2 ...request.Option) (*elbv2.DeleteListenerOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteListenerWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DeleteListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DeleteListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteListenerWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DeleteLoadBalancerRequest(_param0 *elbv2.DeleteLoadBalancerInput) (*request.Request, *elbv2.DeleteLoadBalancerOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteLoadBalancerRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -184,6 +281,22 @@ func (_mr *_MockELBV2APIRecorder) DeleteLoadBalancer(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLoadBalancer", arg0)
 }
 
+func (_m *MockELBV2API) DeleteLoadBalancerWithContext(_param0 aws.Context, _param1 *elbv2.DeleteLoadBalancerInput, _param2 ...request.Option) (*elbv2.DeleteLoadBalancerOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteLoadBalancerWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DeleteLoadBalancerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DeleteLoadBalancerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteLoadBalancerWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DeleteRuleRequest(_param0 *elbv2.DeleteRuleInput) (*request.Request, *elbv2.DeleteRuleOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteRuleRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -206,6 +319,22 @@ func (_mr *_MockELBV2APIRecorder) DeleteRule(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteRule", arg0)
 }
 
+func (_m *MockELBV2API) DeleteRuleWithContext(_param0 aws.Context, _param1 *elbv2.DeleteRuleInput, _param2 ...request.Option) (*elbv2.DeleteRuleOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteRuleWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DeleteRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DeleteRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteRuleWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DeleteTargetGroupRequest(_param0 *elbv2.DeleteTargetGroupInput) (*request.Request, *elbv2.DeleteTargetGroupOutput) {
 	ret := _m.ctrl.Call(_m, "DeleteTargetGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -228,6 +357,22 @@ func (_mr *_MockELBV2APIRecorder) DeleteTargetGroup(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTargetGroup", arg0)
 }
 
+func (_m *MockELBV2API) DeleteTargetGroupWithContext(_param0 aws.Context, _param1 *elbv2.DeleteTargetGroupInput, _param2 ...request.Option) (*elbv2.DeleteTargetGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteTargetGroupWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DeleteTargetGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DeleteTargetGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeleteTargetGroupWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DeregisterTargetsRequest(_param0 *elbv2.DeregisterTargetsInput) (*request.Request, *elbv2.DeregisterTargetsOutput) {
 	ret := _m.ctrl.Call(_m, "DeregisterTargetsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -250,6 +395,22 @@ func (_mr *_MockELBV2APIRecorder) DeregisterTargets(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterTargets", arg0)
 }
 
+func (_m *MockELBV2API) DeregisterTargetsWithContext(_param0 aws.Context, _param1 *elbv2.DeregisterTargetsInput, _param2 ...request.Option) (*elbv2.DeregisterTargetsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeregisterTargetsWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DeregisterTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DeregisterTargetsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DeregisterTargetsWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeListenersRequest(_param0 *elbv2.DescribeListenersInput) (*request.Request, *elbv2.DescribeListenersOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeListenersRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -272,6 +433,22 @@ func (_mr *_MockELBV2APIRecorder) DescribeListeners(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeListeners", arg0)
 }
 
+func (_m *MockELBV2API) DescribeListenersWithContext(_param0 aws.Context, _param1 *elbv2.DescribeListenersInput, _param2 ...request.Option) (*elbv2.DescribeListenersOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeListenersWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DescribeListenersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeListenersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeListenersWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeListenersPages(_param0 *elbv2.DescribeListenersInput, _param1 func(*elbv2.DescribeListenersOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeListenersPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -282,6 +459,21 @@ func (_mr *_MockELBV2APIRecorder) DescribeListenersPages(arg0, arg1 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeListenersPages", arg0, arg1)
 }
 
+func (_m *MockELBV2API) DescribeListenersPagesWithContext(_param0 aws.Context, _param1 *elbv2.DescribeListenersInput, _param2 func(*elbv2.DescribeListenersOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeListenersPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeListenersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeListenersPagesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeLoadBalancerAttributesRequest(_param0 *elbv2.DescribeLoadBalancerAttributesInput) (*request.Request, *elbv2.DescribeLoadBalancerAttributesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeLoadBalancerAttributesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -304,6 +496,22 @@ func (_mr *_MockELBV2APIRecorder) DescribeLoadBalancerAttributes(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancerAttributes", arg0)
 }
 
+func (_m *MockELBV2API) DescribeLoadBalancerAttributesWithContext(_param0 aws.Context, _param1 *elbv2.DescribeLoadBalancerAttributesInput, _param2 ...request.Option) (*elbv2.DescribeLoadBalancerAttributesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancerAttributesWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DescribeLoadBalancerAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeLoadBalancerAttributesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancerAttributesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeLoadBalancersRequest(_param0 *elbv2.DescribeLoadBalancersInput) (*request.Request, *elbv2.DescribeLoadBalancersOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeLoadBalancersRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -326,6 +534,22 @@ func (_mr *_MockELBV2APIRecorder) DescribeLoadBalancers(arg0 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancers", arg0)
 }
 
+func (_m *MockELBV2API) DescribeLoadBalancersWithContext(_param0 aws.Context, _param1 *elbv2.DescribeLoadBalancersInput, _param2 ...request.Option) (*elbv2.DescribeLoadBalancersOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancersWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DescribeLoadBalancersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeLoadBalancersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancersWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeLoadBalancersPages(_param0 *elbv2.DescribeLoadBalancersInput, _param1 func(*elbv2.DescribeLoadBalancersOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeLoadBalancersPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -336,6 +560,21 @@ func (_mr *_MockELBV2APIRecorder) DescribeLoadBalancersPages(arg0, arg1 interfac
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancersPages", arg0, arg1)
 }
 
+func (_m *MockELBV2API) DescribeLoadBalancersPagesWithContext(_param0 aws.Context, _param1 *elbv2.DescribeLoadBalancersInput, _param2 func(*elbv2.DescribeLoadBalancersOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeLoadBalancersPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeLoadBalancersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeLoadBalancersPagesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeRulesRequest(_param0 *elbv2.DescribeRulesInput) (*request.Request, *elbv2.DescribeRulesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeRulesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -358,6 +597,22 @@ func (_mr *_MockELBV2APIRecorder) DescribeRules(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeRules", arg0)
 }
 
+func (_m *MockELBV2API) DescribeRulesWithContext(_param0 aws.Context, _param1 *elbv2.DescribeRulesInput, _param2 ...request.Option) (*elbv2.DescribeRulesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeRulesWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DescribeRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeRulesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeRulesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeSSLPoliciesRequest(_param0 *elbv2.DescribeSSLPoliciesInput) (*request.Request, *elbv2.DescribeSSLPoliciesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeSSLPoliciesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -380,6 +635,22 @@ func (_mr *_MockELBV2APIRecorder) DescribeSSLPolicies(arg0 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSSLPolicies", arg0)
 }
 
+func (_m *MockELBV2API) DescribeSSLPoliciesWithContext(_param0 aws.Context, _param1 *elbv2.DescribeSSLPoliciesInput, _param2 ...request.Option) (*elbv2.DescribeSSLPoliciesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeSSLPoliciesWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DescribeSSLPoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeSSLPoliciesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeSSLPoliciesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeTagsRequest(_param0 *elbv2.DescribeTagsInput) (*request.Request, *elbv2.DescribeTagsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeTagsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -402,6 +673,22 @@ func (_mr *_MockELBV2APIRecorder) DescribeTags(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTags", arg0)
 }
 
+func (_m *MockELBV2API) DescribeTagsWithContext(_param0 aws.Context, _param1 *elbv2.DescribeTagsInput, _param2 ...request.Option) (*elbv2.DescribeTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTagsWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DescribeTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTagsWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeTargetGroupAttributesRequest(_param0 *elbv2.DescribeTargetGroupAttributesInput) (*request.Request, *elbv2.DescribeTargetGroupAttributesOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeTargetGroupAttributesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -424,6 +711,22 @@ func (_mr *_MockELBV2APIRecorder) DescribeTargetGroupAttributes(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTargetGroupAttributes", arg0)
 }
 
+func (_m *MockELBV2API) DescribeTargetGroupAttributesWithContext(_param0 aws.Context, _param1 *elbv2.DescribeTargetGroupAttributesInput, _param2 ...request.Option) (*elbv2.DescribeTargetGroupAttributesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTargetGroupAttributesWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DescribeTargetGroupAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeTargetGroupAttributesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTargetGroupAttributesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeTargetGroupsRequest(_param0 *elbv2.DescribeTargetGroupsInput) (*request.Request, *elbv2.DescribeTargetGroupsOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeTargetGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -446,6 +749,22 @@ func (_mr *_MockELBV2APIRecorder) DescribeTargetGroups(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTargetGroups", arg0)
 }
 
+func (_m *MockELBV2API) DescribeTargetGroupsWithContext(_param0 aws.Context, _param1 *elbv2.DescribeTargetGroupsInput, _param2 ...request.Option) (*elbv2.DescribeTargetGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTargetGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DescribeTargetGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeTargetGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTargetGroupsWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeTargetGroupsPages(_param0 *elbv2.DescribeTargetGroupsInput, _param1 func(*elbv2.DescribeTargetGroupsOutput, bool) bool) error {
 	ret := _m.ctrl.Call(_m, "DescribeTargetGroupsPages", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -456,6 +775,21 @@ func (_mr *_MockELBV2APIRecorder) DescribeTargetGroupsPages(arg0, arg1 interface
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTargetGroupsPages", arg0, arg1)
 }
 
+func (_m *MockELBV2API) DescribeTargetGroupsPagesWithContext(_param0 aws.Context, _param1 *elbv2.DescribeTargetGroupsInput, _param2 func(*elbv2.DescribeTargetGroupsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTargetGroupsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeTargetGroupsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTargetGroupsPagesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) DescribeTargetHealthRequest(_param0 *elbv2.DescribeTargetHealthInput) (*request.Request, *elbv2.DescribeTargetHealthOutput) {
 	ret := _m.ctrl.Call(_m, "DescribeTargetHealthRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -478,6 +812,22 @@ func (_mr *_MockELBV2APIRecorder) DescribeTargetHealth(arg0 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTargetHealth", arg0)
 }
 
+func (_m *MockELBV2API) DescribeTargetHealthWithContext(_param0 aws.Context, _param1 *elbv2.DescribeTargetHealthInput, _param2 ...request.Option) (*elbv2.DescribeTargetHealthOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DescribeTargetHealthWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.DescribeTargetHealthOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) DescribeTargetHealthWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DescribeTargetHealthWithContext", _s...)
+}
+
 func (_m *MockELBV2API) ModifyListenerRequest(_param0 *elbv2.ModifyListenerInput) (*request.Request, *elbv2.ModifyListenerOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyListenerRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -500,6 +850,22 @@ func (_mr *_MockELBV2APIRecorder) ModifyListener(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyListener", arg0)
 }
 
+func (_m *MockELBV2API) ModifyListenerWithContext(_param0 aws.Context, _param1 *elbv2.ModifyListenerInput, _param2 ...request.Option) (*elbv2.ModifyListenerOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyListenerWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.ModifyListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) ModifyListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyListenerWithContext", _s...)
+}
+
 func (_m *MockELBV2API) ModifyLoadBalancerAttributesRequest(_param0 *elbv2.ModifyLoadBalancerAttributesInput) (*request.Request, *elbv2.ModifyLoadBalancerAttributesOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyLoadBalancerAttributesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -522,6 +888,22 @@ func (_mr *_MockELBV2APIRecorder) ModifyLoadBalancerAttributes(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyLoadBalancerAttributes", arg0)
 }
 
+func (_m *MockELBV2API) ModifyLoadBalancerAttributesWithContext(_param0 aws.Context, _param1 *elbv2.ModifyLoadBalancerAttributesInput, _param2 ...request.Option) (*elbv2.ModifyLoadBalancerAttributesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyLoadBalancerAttributesWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.ModifyLoadBalancerAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) ModifyLoadBalancerAttributesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyLoadBalancerAttributesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) ModifyRuleRequest(_param0 *elbv2.ModifyRuleInput) (*request.Request, *elbv2.ModifyRuleOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyRuleRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -544,6 +926,22 @@ func (_mr *_MockELBV2APIRecorder) ModifyRule(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyRule", arg0)
 }
 
+func (_m *MockELBV2API) ModifyRuleWithContext(_param0 aws.Context, _param1 *elbv2.ModifyRuleInput, _param2 ...request.Option) (*elbv2.ModifyRuleOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyRuleWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.ModifyRuleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) ModifyRuleWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyRuleWithContext", _s...)
+}
+
 func (_m *MockELBV2API) ModifyTargetGroupRequest(_param0 *elbv2.ModifyTargetGroupInput) (*request.Request, *elbv2.ModifyTargetGroupOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyTargetGroupRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -566,6 +964,22 @@ func (_mr *_MockELBV2APIRecorder) ModifyTargetGroup(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyTargetGroup", arg0)
 }
 
+func (_m *MockELBV2API) ModifyTargetGroupWithContext(_param0 aws.Context, _param1 *elbv2.ModifyTargetGroupInput, _param2 ...request.Option) (*elbv2.ModifyTargetGroupOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyTargetGroupWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.ModifyTargetGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) ModifyTargetGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyTargetGroupWithContext", _s...)
+}
+
 func (_m *MockELBV2API) ModifyTargetGroupAttributesRequest(_param0 *elbv2.ModifyTargetGroupAttributesInput) (*request.Request, *elbv2.ModifyTargetGroupAttributesOutput) {
 	ret := _m.ctrl.Call(_m, "ModifyTargetGroupAttributesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -588,6 +1002,22 @@ func (_mr *_MockELBV2APIRecorder) ModifyTargetGroupAttributes(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyTargetGroupAttributes", arg0)
 }
 
+func (_m *MockELBV2API) ModifyTargetGroupAttributesWithContext(_param0 aws.Context, _param1 *elbv2.ModifyTargetGroupAttributesInput, _param2 ...request.Option) (*elbv2.ModifyTargetGroupAttributesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ModifyTargetGroupAttributesWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.ModifyTargetGroupAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) ModifyTargetGroupAttributesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ModifyTargetGroupAttributesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) RegisterTargetsRequest(_param0 *elbv2.RegisterTargetsInput) (*request.Request, *elbv2.RegisterTargetsOutput) {
 	ret := _m.ctrl.Call(_m, "RegisterTargetsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -610,6 +1040,22 @@ func (_mr *_MockELBV2APIRecorder) RegisterTargets(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterTargets", arg0)
 }
 
+func (_m *MockELBV2API) RegisterTargetsWithContext(_param0 aws.Context, _param1 *elbv2.RegisterTargetsInput, _param2 ...request.Option) (*elbv2.RegisterTargetsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RegisterTargetsWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.RegisterTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) RegisterTargetsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RegisterTargetsWithContext", _s...)
+}
+
 func (_m *MockELBV2API) RemoveTagsRequest(_param0 *elbv2.RemoveTagsInput) (*request.Request, *elbv2.RemoveTagsOutput) {
 	ret := _m.ctrl.Call(_m, "RemoveTagsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -632,6 +1078,22 @@ func (_mr *_MockELBV2APIRecorder) RemoveTags(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveTags", arg0)
 }
 
+func (_m *MockELBV2API) RemoveTagsWithContext(_param0 aws.Context, _param1 *elbv2.RemoveTagsInput, _param2 ...request.Option) (*elbv2.RemoveTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RemoveTagsWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.RemoveTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) RemoveTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveTagsWithContext", _s...)
+}
+
 func (_m *MockELBV2API) SetIpAddressTypeRequest(_param0 *elbv2.SetIpAddressTypeInput) (*request.Request, *elbv2.SetIpAddressTypeOutput) {
 	ret := _m.ctrl.Call(_m, "SetIpAddressTypeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -654,6 +1116,22 @@ func (_mr *_MockELBV2APIRecorder) SetIpAddressType(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetIpAddressType", arg0)
 }
 
+func (_m *MockELBV2API) SetIpAddressTypeWithContext(_param0 aws.Context, _param1 *elbv2.SetIpAddressTypeInput, _param2 ...request.Option) (*elbv2.SetIpAddressTypeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetIpAddressTypeWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.SetIpAddressTypeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) SetIpAddressTypeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetIpAddressTypeWithContext", _s...)
+}
+
 func (_m *MockELBV2API) SetRulePrioritiesRequest(_param0 *elbv2.SetRulePrioritiesInput) (*request.Request, *elbv2.SetRulePrioritiesOutput) {
 	ret := _m.ctrl.Call(_m, "SetRulePrioritiesRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -676,6 +1154,22 @@ func (_mr *_MockELBV2APIRecorder) SetRulePriorities(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetRulePriorities", arg0)
 }
 
+func (_m *MockELBV2API) SetRulePrioritiesWithContext(_param0 aws.Context, _param1 *elbv2.SetRulePrioritiesInput, _param2 ...request.Option) (*elbv2.SetRulePrioritiesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetRulePrioritiesWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.SetRulePrioritiesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) SetRulePrioritiesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetRulePrioritiesWithContext", _s...)
+}
+
 func (_m *MockELBV2API) SetSecurityGroupsRequest(_param0 *elbv2.SetSecurityGroupsInput) (*request.Request, *elbv2.SetSecurityGroupsOutput) {
 	ret := _m.ctrl.Call(_m, "SetSecurityGroupsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -698,6 +1192,22 @@ func (_mr *_MockELBV2APIRecorder) SetSecurityGroups(arg0 interface{}) *gomock.Ca
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetSecurityGroups", arg0)
 }
 
+func (_m *MockELBV2API) SetSecurityGroupsWithContext(_param0 aws.Context, _param1 *elbv2.SetSecurityGroupsInput, _param2 ...request.Option) (*elbv2.SetSecurityGroupsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetSecurityGroupsWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.SetSecurityGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) SetSecurityGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetSecurityGroupsWithContext", _s...)
+}
+
 func (_m *MockELBV2API) SetSubnetsRequest(_param0 *elbv2.SetSubnetsInput) (*request.Request, *elbv2.SetSubnetsOutput) {
 	ret := _m.ctrl.Call(_m, "SetSubnetsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
@@ -719,3 +1229,19 @@ func (_m *MockELBV2API) SetSubnets(_param0 *elbv2.SetSubnetsInput) (*elbv2.SetSu
 func (_mr *_MockELBV2APIRecorder) SetSubnets(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetSubnets", arg0)
 }
+
+func (_m *MockELBV2API) SetSubnetsWithContext(_param0 aws.Context, _param1 *elbv2.SetSubnetsInput, _param2 ...request.Option) (*elbv2.SetSubnetsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "SetSubnetsWithContext", _s...)
+	ret0, _ := ret[0].(*elbv2.SetSubnetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockELBV2APIRecorder) SetSubnetsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetSubnetsWithContext", _s...)
+}
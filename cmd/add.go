@@ -1,20 +1,33 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
-	"time"
+	"strconv"
 
 	"github.com/dtan4/esnctl/aws"
+	"github.com/dtan4/esnctl/aws/ec2"
 	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/dtan4/esnctl/state"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
 const (
-	addMaxRetry     = 120
-	addSleepSeconds = 5
+	addValueAddedInstances    = "added_instances"
+	addValueClusterURL        = "cluster_url"
+	addValueDelta             = "delta"
+	addValueDesiredCapacity   = "desired_capacity"
+	addValueForce             = "force"
+	addValueAutoScalingGroup  = "group"
+	addValuePreviousCapacity  = "previous_capacity"
+	addValuePreviousInstances = "previous_instances"
+	addValueRegion            = "region"
+	addValueRole              = "role"
+	addValueRollback          = "rollback_on_failure"
 )
 
 // addCmd represents the add command
@@ -30,8 +43,16 @@ var addOpts = struct {
 	autoScalingGroup string
 	clusterURL       string
 	delta            int
+	dryRun           bool
+	force            bool
 	region           string
-}{}
+	role             string
+	rollback         bool
+	stateDir         string
+	wait             waitConfig
+}{
+	wait: defaultWaitConfig(),
+}
 
 func doAdd(cmd *cobra.Command, args []string) error {
 	if addOpts.clusterURL == "" {
@@ -46,6 +67,60 @@ func doAdd(cmd *cobra.Command, args []string) error {
 		return errors.New("number to add instances must be greater than 0")
 	}
 
+	if err := addOpts.wait.validate(); err != nil {
+		return err
+	}
+
+	ctx, stopHandlingSignals := newSignalContext()
+	defer stopHandlingSignals()
+
+	if addOpts.dryRun {
+		return planAdd(ctx)
+	}
+
+	values := map[string]string{
+		addValueAutoScalingGroup: addOpts.autoScalingGroup,
+		addValueClusterURL:       addOpts.clusterURL,
+		addValueDelta:            strconv.Itoa(addOpts.delta),
+		addValueForce:            strconv.FormatBool(addOpts.force),
+		addValueRegion:           addOpts.region,
+		addValueRole:             addOpts.role,
+		addValueRollback:         strconv.FormatBool(addOpts.rollback),
+	}
+	addOpts.wait.setValues(values)
+
+	run, err := newRun(addOpts.stateDir, "add", values)
+	if err != nil {
+		return err
+	}
+
+	return runAdd(ctx, run)
+}
+
+func resumeAdd(ctx context.Context, run *state.Run) error {
+	delta, err := strconv.Atoi(run.Get(addValueDelta))
+	if err != nil {
+		return errors.Wrap(err, "invalid number to add instances in state file")
+	}
+
+	wait, err := loadWaitConfig(run)
+	if err != nil {
+		return err
+	}
+
+	addOpts.autoScalingGroup = run.Get(addValueAutoScalingGroup)
+	addOpts.clusterURL = run.Get(addValueClusterURL)
+	addOpts.delta = delta
+	addOpts.force = run.Get(addValueForce) == "true"
+	addOpts.region = run.Get(addValueRegion)
+	addOpts.role = run.Get(addValueRole)
+	addOpts.rollback = run.Get(addValueRollback) == "true"
+	addOpts.wait = wait
+
+	return runAdd(ctx, run)
+}
+
+func runAdd(ctx context.Context, run *state.Run) error {
 	httpClient := &http.Client{}
 
 	client, err := es.New(addOpts.clusterURL, httpClient)
@@ -57,51 +132,291 @@ func doAdd(cmd *cobra.Command, args []string) error {
 		return errors.Wrap(err, "failed to initialize AWS service clients")
 	}
 
-	log.Println("===> Disabling shard reallocation...")
+	restoreReallocation := newReallocationRestorer(run, client, "")
 
-	if err := client.DisableReallocation(); err != nil {
-		return errors.Wrap(err, "failed to disable reallocation")
+	steps := []step{
+		checkClusterHealthStep(client, addOpts.force),
 	}
+	steps = append(steps, addSteps(run, client, "", addOpts.autoScalingGroup, addOpts.delta, addOpts.role, addOpts.wait)...)
 
-	log.Printf("===> Launching %d instances on %s...\n", addOpts.delta, addOpts.autoScalingGroup)
+	if err := runSteps(ctx, run, steps, addOpts.rollback); err != nil {
+		restoreReallocation()
+		return err
+	}
 
-	desiredCapacity, err := aws.AutoScaling.IncreaseInstances(addOpts.autoScalingGroup, addOpts.delta)
-	if err != nil {
-		return errors.Wrap(err, "failed to increase instance")
+	return nil
+}
+
+// addSteps returns the steps to add delta instances to the given Auto Scaling Group
+// Step names and values of the run are prefixed with prefix, so that one run can contain multiple add workflows
+// If role is not empty, added instances are waited for until they join as nodes having the role
+func addSteps(run *state.Run, client es.Client, prefix, group string, delta int, role string, wait waitConfig) []step {
+	return []step{
+		disableReallocationStep(client, prefix),
+		{
+			name:        prefix + "increase-instances",
+			description: fmt.Sprintf("Launching %d instances on %s", delta, group),
+			run: func(ctx context.Context) error {
+				// Target capacity is saved before being applied, so that resuming this step
+				// never increases the capacity twice.
+				if run.Get(prefix+addValueDesiredCapacity) == "" {
+					currentDesiredCapacity, err := aws.AutoScaling.RetrieveDesiredCapacity(ctx, group)
+					if err != nil {
+						return errors.Wrap(err, "failed to retrieve desired capacity")
+					}
+
+					// Instances launched by this step are told from existing ones by ID, so that nodes
+					// outside the ASG, e.g. dedicated master nodes, do not affect waiting for them to join
+					instanceIDs, err := aws.AutoScaling.ListInstances(ctx, group)
+					if err != nil {
+						return errors.Wrap(err, "failed to list instances")
+					}
+
+					if err := run.Set(prefix+addValuePreviousInstances, joinValues(instanceIDs)); err != nil {
+						return errors.Wrap(err, "failed to save existing instances")
+					}
+
+					if err := run.Set(prefix+addValuePreviousCapacity, strconv.Itoa(currentDesiredCapacity)); err != nil {
+						return errors.Wrap(err, "failed to save desired capacity")
+					}
+
+					if err := run.Set(prefix+addValueDesiredCapacity, strconv.Itoa(currentDesiredCapacity+delta)); err != nil {
+						return errors.Wrap(err, "failed to save desired capacity")
+					}
+				}
+
+				desiredCapacity, err := strconv.Atoi(run.Get(prefix + addValueDesiredCapacity))
+				if err != nil {
+					return errors.Wrap(err, "invalid desired capacity in state file")
+				}
+
+				if err := aws.AutoScaling.SetDesiredCapacity(ctx, group, desiredCapacity); err != nil {
+					return errors.Wrap(err, "failed to increase instance")
+				}
+
+				return nil
+			},
+			rollback: func(ctx context.Context) error {
+				if run.Get(prefix+addValuePreviousCapacity) == "" {
+					return nil
+				}
+
+				previousCapacity, err := strconv.Atoi(run.Get(prefix + addValuePreviousCapacity))
+				if err != nil {
+					return errors.Wrap(err, "invalid previous desired capacity in state file")
+				}
+
+				// Only the instances launched by this run are terminated. Lowering desired capacity instead
+				// lets ASG choose instances to terminate, which may be existing nodes holding shards.
+				// Instances launched by ASG itself during the run, e.g. health replacements, are not in the list.
+				instanceIDs, err := aws.AutoScaling.ListInstances(ctx, group)
+				if err != nil {
+					return errors.Wrap(err, "failed to list instances")
+				}
+
+				// Instances already terminated by an interrupted rollback must be skipped
+				added := []string{}
+
+				for _, instanceID := range splitValues(run.Get(prefix + addValueAddedInstances)) {
+					if contains(instanceIDs, instanceID) {
+						added = append(added, instanceID)
+					}
+				}
+
+				if len(added) > 0 {
+					if err := aws.AutoScaling.TerminateInstances(ctx, added); err != nil {
+						return errors.Wrap(err, "failed to terminate added instances")
+					}
+				}
+
+				// Desired capacity still exceeds the previous one if some instances have not been launched yet.
+				// Lowering it cancels their launch.
+				desiredCapacity, err := aws.AutoScaling.RetrieveDesiredCapacity(ctx, group)
+				if err != nil {
+					return errors.Wrap(err, "failed to retrieve desired capacity")
+				}
+
+				if desiredCapacity > previousCapacity {
+					if err := aws.AutoScaling.SetDesiredCapacity(ctx, group, previousCapacity); err != nil {
+						return errors.Wrap(err, "failed to reset desired capacity")
+					}
+				}
+
+				return run.Set(prefix+addValueDesiredCapacity, "")
+			},
+		},
+		{
+			name:        prefix + "wait-nodes-join",
+			description: "Waiting for nodes join to Elasticsearch cluster",
+			run: func(ctx context.Context) error {
+				previousInstances := splitValues(run.Get(prefix + addValuePreviousInstances))
+
+				var added, unjoined []string
+
+				err := wait.waitUntil(ctx, wait.joinTimeout, func() (bool, error) {
+					var err error
+
+					added, unjoined, err = retrieveAddedInstances(ctx, client, group, role, previousInstances)
+					if err != nil {
+						return false, err
+					}
+
+					// Added instances are saved as soon as they are launched, so that rollback can terminate them
+					// even if they never join the cluster
+					if joinValues(added) != run.Get(prefix+addValueAddedInstances) {
+						if err := run.Set(prefix+addValueAddedInstances, joinValues(added)); err != nil {
+							return false, errors.Wrap(err, "failed to save added instances")
+						}
+					}
+
+					return len(added) >= delta && len(unjoined) == 0, nil
+				})
+				if err == errWaitTimeout {
+					if len(added) < delta {
+						return errors.Errorf("timed out: only %d of %d instances are launched within %s", len(added), delta, wait.joinTimeout)
+					}
+
+					return errors.Errorf("timed out: added instances %s do not join to Elasticsearch cluster within %s", joinValues(unjoined), wait.joinTimeout)
+				}
+
+				return err
+			},
+		},
+		enableReallocationStep(client, prefix),
+		waitClusterGreenStep(client, prefix, wait),
 	}
+}
 
-	log.Println("===> Waiting for nodes join to Elasticsearch cluster...")
+// retrieveAddedInstances returns the instances in the given ASG which are not in previousInstances,
+// and those of them which have not joined the cluster yet as nodes having the given role
+func retrieveAddedInstances(ctx context.Context, client es.Client, group, role string, previousInstances []string) ([]string, []string, error) {
+	instanceIDs, err := aws.AutoScaling.ListInstances(ctx, group)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list instances")
+	}
 
-	retryCount := 0
+	added := []string{}
 
-	for {
-		nodes, err := client.ListNodes()
-		if err != nil {
-			return errors.Wrap(err, "failed to list nodes")
+	for _, instanceID := range instanceIDs {
+		if !contains(previousInstances, instanceID) {
+			added = append(added, instanceID)
 		}
+	}
+
+	if len(added) == 0 {
+		return added, []string{}, nil
+	}
+
+	instances, err := aws.EC2.DescribeInstances(ctx, added)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to describe added instances")
+	}
+
+	nodes, err := client.DescribeNodes(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to describe nodes")
+	}
+
+	joined := joinedInstances(instances, filterNodesByRole(nodes, role))
+	unjoined := []string{}
 
-		if len(nodes) == desiredCapacity {
-			fmt.Print("\n")
-			break
+	for _, instanceID := range added {
+		if !contains(joined, instanceID) {
+			unjoined = append(unjoined, instanceID)
 		}
+	}
 
-		fmt.Print(".")
+	return added, unjoined, nil
+}
+
+// joinedInstances returns the IDs of the given instances running any of the given nodes
+// Node runs the instance if its IP is the private IP of the instance, or its aws_instance_id attribute is the instance ID
+func joinedInstances(instances []*ec2.Instance, nodes []*types.Node) []string {
+	joined := []string{}
 
-		if retryCount == addMaxRetry {
-			return errors.New("timed out: added nodes do not join to Elasticsearch cluster")
+	for _, instance := range instances {
+		for _, node := range nodes {
+			if (instance.PrivateIP != "" && node.IP == instance.PrivateIP) || node.Attributes["aws_instance_id"] == instance.ID {
+				joined = append(joined, instance.ID)
+				break
+			}
 		}
+	}
+
+	return joined
+}
+
+// planAdd prints what runAdd will do, calling only read APIs
+func planAdd(ctx context.Context) error {
+	httpClient, transport := newDryRunHTTPClient()
+
+	client, err := es.New(addOpts.clusterURL, httpClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	if err := aws.Initialize(addOpts.region); err != nil {
+		return errors.Wrap(err, "failed to initialize AWS service clients")
+	}
+
+	if err := checkClusterHealth(ctx, client, addOpts.force); err != nil {
+		return err
+	}
+
+	health, err := client.ClusterHealth(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve cluster health")
+	}
+
+	nodes, err := client.ListNodes(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list nodes")
+	}
+
+	currentDesiredCapacity, err := aws.AutoScaling.RetrieveDesiredCapacity(ctx, addOpts.autoScalingGroup)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve desired capacity")
+	}
+
+	desiredCapacity := currentDesiredCapacity + addOpts.delta
 
-		retryCount++
-		time.Sleep(addSleepSeconds * time.Second)
+	if err := client.DisableReallocation(ctx); err != nil {
+		return errors.Wrap(err, "failed to disable reallocation")
 	}
 
-	log.Println("===> Enabling shard reallocation...")
+	disableRequests := transport.take()
 
-	if err := client.EnableReallocation(); err != nil {
+	if err := client.EnableReallocation(ctx); err != nil {
 		return errors.Wrap(err, "failed to enable reallocation")
 	}
 
-	log.Println("===> Finished!")
+	enableRequests := transport.take()
+
+	log.Println("===> Dry run: no changes will be made")
+
+	fmt.Printf("Auto Scaling Group: %s\n", addOpts.autoScalingGroup)
+	fmt.Printf("DesiredCapacity: %d -> %d\n", currentDesiredCapacity, desiredCapacity)
+	fmt.Printf("Elasticsearch nodes: %d\n", len(nodes))
+	fmt.Printf("Cluster health: %s\n", health.Status)
+	fmt.Println("Steps:")
+
+	printPlanStep(1, "Checking cluster health", []string{})
+	printPlanStep(2, "Disabling shard reallocation", disableRequests)
+	printPlanStep(3, fmt.Sprintf("Launching %d instances on %s", addOpts.delta, addOpts.autoScalingGroup), []string{
+		fmt.Sprintf("SetDesiredCapacity %s: %d -> %d", addOpts.autoScalingGroup, currentDesiredCapacity, desiredCapacity),
+	})
+	joinCondition := fmt.Sprintf("until %d instances launched on %s join", addOpts.delta, addOpts.autoScalingGroup)
+	if addOpts.role != "" {
+		joinCondition += fmt.Sprintf(" as %s nodes", addOpts.role)
+	}
+
+	printPlanStep(4, "Waiting for nodes join to Elasticsearch cluster", []string{
+		fmt.Sprintf("%s, up to %s", joinCondition, addOpts.wait.joinTimeout),
+	})
+	printPlanStep(5, "Enabling shard reallocation", enableRequests)
+	printPlanStep(6, "Waiting for cluster health to be green", []string{
+		fmt.Sprintf("up to %s", addOpts.wait.healthTimeout),
+	})
 
 	return nil
 }
@@ -109,8 +424,17 @@ func doAdd(cmd *cobra.Command, args []string) error {
 func init() {
 	RootCmd.AddCommand(addCmd)
 
+	addCmd.Flags().BoolVar(&addOpts.wait.backoff, "backoff", false, "Double poll interval after each poll, up to 1m")
 	addCmd.Flags().StringVar(&addOpts.autoScalingGroup, "group", "", "Auto Scaling Group")
 	addCmd.Flags().StringVar(&addOpts.clusterURL, "cluster-url", "", "Elasticsearch cluster URL")
+	addCmd.Flags().BoolVar(&addOpts.dryRun, "dry-run", false, "Print what will be done without making any changes")
+	addCmd.Flags().BoolVar(&addOpts.force, "force", false, "Start even if the cluster is not green")
+	addCmd.Flags().DurationVar(&addOpts.wait.healthTimeout, "health-timeout", defaultHealthTimeout, "Time to wait for the cluster to be green after enabling reallocation")
+	addCmd.Flags().DurationVar(&addOpts.wait.joinTimeout, "join-timeout", defaultJoinTimeout, "Time to wait for added nodes to join the cluster")
 	addCmd.Flags().IntVarP(&addOpts.delta, "number", "n", 0, "Number to add instances")
+	addCmd.Flags().DurationVar(&addOpts.wait.pollInterval, "poll-interval", defaultPollInterval, "Interval to poll Elasticsearch and AWS while waiting")
 	addCmd.Flags().StringVar(&addOpts.region, "region", "", "AWS region")
+	addCmd.Flags().StringVar(&addOpts.role, "role", "", "Wait until added nodes join with the given role, e.g. data or master")
+	addCmd.Flags().BoolVar(&addOpts.rollback, "rollback-on-failure", false, "Terminate the instances launched by this run if added nodes fail to join")
+	addCmd.Flags().StringVar(&addOpts.stateDir, "state-dir", "", "Directory to store progress of this run (default: ~/.esnctl/runs)")
 }
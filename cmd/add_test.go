@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dtan4/esnctl/aws/ec2"
+	"github.com/dtan4/esnctl/es/types"
+)
+
+func TestJoinedInstances(t *testing.T) {
+	instances := []*ec2.Instance{
+		&ec2.Instance{ID: "i-1111aaaa", PrivateIP: "10.0.1.21"},
+		&ec2.Instance{ID: "i-2222bbbb", PrivateIP: "10.0.1.22"},
+		&ec2.Instance{ID: "i-3333cccc", PrivateIP: "10.0.1.23"},
+		// Pending instance may not have private IP yet
+		&ec2.Instance{ID: "i-4444dddd"},
+	}
+
+	nodes := []*types.Node{
+		// Dedicated master node outside the ASG
+		&types.Node{Name: "master-a", IP: "10.0.0.10", Attributes: map[string]string{}},
+		&types.Node{Name: "node-a", IP: "10.0.1.21", Attributes: map[string]string{}},
+		&types.Node{Name: "node-c", IP: "172.17.0.2", Attributes: map[string]string{"aws_instance_id": "i-3333cccc"}},
+		&types.Node{Name: "node-x", Attributes: map[string]string{}},
+	}
+
+	expected := []string{"i-1111aaaa", "i-3333cccc"}
+
+	if got := joinedInstances(instances, nodes); !reflect.DeepEqual(got, expected) {
+		t.Errorf("joined instances does not match. expected: %q, got: %q", expected, got)
+	}
+}
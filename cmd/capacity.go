@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/pkg/errors"
+)
+
+// diskCapacity represents whether the remaining nodes can absorb data on the nodes to be removed
+type diskCapacity struct {
+	// required is disk usage of the nodes to be removed
+	required int64
+	// availableLow and availableHigh are how many bytes the remaining nodes can receive
+	// before reaching the low / high disk watermark
+	availableLow  int64
+	availableHigh int64
+	watermarks    *types.DiskWatermarks
+}
+
+// estimateDiskCapacity compares disk usage of the given nodes with free space of the other nodes
+// Only data nodes not excluded from shard allocation can receive shards, so free space of other nodes is not counted
+// Disk usage includes files other than shards, so the required space is overestimated a little
+func estimateDiskCapacity(allocations []*types.Allocation, nodes []*types.Node, exclude map[string][]string, nodeNames []string, watermarks *types.DiskWatermarks) (*diskCapacity, error) {
+	low, high := watermarks.Low, watermarks.High
+
+	// Shards are allocated regardless of watermarks, but cannot exceed the disk size
+	if !watermarks.ThresholdEnabled {
+		low, high = "100%", "100%"
+	}
+
+	capacity := &diskCapacity{
+		watermarks: watermarks,
+	}
+
+	receivers := []string{}
+
+	for _, node := range nodes {
+		if node.HasRole("data") && !excludedFromAllocation(node, exclude) {
+			receivers = append(receivers, node.Name)
+		}
+	}
+
+	for _, allocation := range allocations {
+		if contains(nodeNames, allocation.NodeName) {
+			capacity.required += allocation.DiskUsedBytes
+			continue
+		}
+
+		if !contains(receivers, allocation.NodeName) {
+			continue
+		}
+
+		usableLow, err := types.UsableBytes(low, allocation.DiskTotalBytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse low disk watermark")
+		}
+
+		usableHigh, err := types.UsableBytes(high, allocation.DiskTotalBytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse high disk watermark")
+		}
+
+		if usableLow > allocation.DiskUsedBytes {
+			capacity.availableLow += usableLow - allocation.DiskUsedBytes
+		}
+
+		if usableHigh > allocation.DiskUsedBytes {
+			capacity.availableHigh += usableHigh - allocation.DiskUsedBytes
+		}
+	}
+
+	return capacity, nil
+}
+
+// retrieveDiskCapacity estimates disk capacity of the cluster after removing the given nodes
+func retrieveDiskCapacity(ctx context.Context, client es.Client, nodeNames []string) (*diskCapacity, error) {
+	allocations, err := client.ListAllocations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list allocations")
+	}
+
+	watermarks, err := client.DiskWatermarks(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve disk watermarks")
+	}
+
+	nodes, err := client.DescribeNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe nodes")
+	}
+
+	settings, err := client.AllocationSettings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve allocation settings")
+	}
+
+	return estimateDiskCapacity(allocations, nodes, settings.Exclude, nodeNames, watermarks)
+}
+
+// excludedFromAllocation returns whether the given node matches any of cluster.routing.allocation.exclude.* values
+func excludedFromAllocation(node *types.Node, exclude map[string][]string) bool {
+	for attribute, values := range exclude {
+		if contains(values, node.AllocationFilterValue(attribute)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkDiskCapacity refuses to remove the given nodes if the remaining nodes cannot absorb their data
+// without exceeding the high disk watermark. Only a warning is printed if force is true
+func checkDiskCapacity(ctx context.Context, client es.Client, nodeNames []string, force bool) error {
+	capacity, err := retrieveDiskCapacity(ctx, client, nodeNames)
+	if err != nil {
+		return err
+	}
+
+	if capacity.required > capacity.availableHigh {
+		message := fmt.Sprintf("remaining nodes cannot absorb data on target nodes (required: %s, available under high watermark %s: %s)",
+			formatBytes(capacity.required), capacity.watermarks.High, formatBytes(capacity.availableHigh))
+
+		if !force {
+			return errors.New(message + ". Use --force to proceed anyway")
+		}
+
+		log.Printf("===> Warning: %s\n", message)
+
+		return nil
+	}
+
+	if capacity.required > capacity.availableLow {
+		log.Printf("===> Warning: remaining nodes will exceed low watermark %s (required: %s, available: %s)\n",
+			capacity.watermarks.Low, formatBytes(capacity.required), formatBytes(capacity.availableLow))
+	}
+
+	return nil
+}
+
+// formatBytes formats the given bytes in human readable form
+func formatBytes(bytes int64) string {
+	units := []string{"b", "kb", "mb", "gb", "tb"}
+
+	value := float64(bytes)
+	i := 0
+
+	for value >= 1024 && i < len(units)-1 {
+		value /= 1024
+		i++
+	}
+
+	if i == 0 {
+		return fmt.Sprintf("%d%s", bytes, units[i])
+	}
+
+	return fmt.Sprintf("%.1f%s", value, units[i])
+}
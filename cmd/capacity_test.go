@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dtan4/esnctl/es/types"
+)
+
+func TestEstimateDiskCapacity(t *testing.T) {
+	allocations := []*types.Allocation{
+		&types.Allocation{
+			NodeName:       "node-a",
+			DiskUsedBytes:  300,
+			DiskTotalBytes: 1000,
+		},
+		&types.Allocation{
+			NodeName:       "node-b",
+			DiskUsedBytes:  600,
+			DiskTotalBytes: 1000,
+		},
+		&types.Allocation{
+			NodeName:       "node-c",
+			DiskUsedBytes:  880,
+			DiskTotalBytes: 1000,
+		},
+		&types.Allocation{
+			NodeName:       "node-d",
+			DiskUsedBytes:  0,
+			DiskTotalBytes: 1000,
+		},
+		&types.Allocation{
+			NodeName:       "node-e",
+			DiskUsedBytes:  100,
+			DiskTotalBytes: 1000,
+		},
+	}
+
+	// node-d is master-only and node-e is already excluded, so neither of them receives shards
+	nodes := []*types.Node{
+		&types.Node{Name: "node-a", IP: "10.0.1.21", Roles: []string{"master", "data"}},
+		&types.Node{Name: "node-b", IP: "10.0.1.22", Roles: []string{"master", "data"}},
+		&types.Node{Name: "node-c", IP: "10.0.1.23", Roles: []string{"data_hot"}},
+		&types.Node{Name: "node-d", IP: "10.0.1.24", Roles: []string{"master"}},
+		&types.Node{Name: "node-e", IP: "10.0.1.25", Roles: []string{"data"}},
+	}
+
+	exclude := map[string][]string{
+		"_ip": []string{"10.0.1.25"},
+	}
+
+	testcases := []struct {
+		watermarks *types.DiskWatermarks
+		expected   *diskCapacity
+	}{
+		{
+			watermarks: &types.DiskWatermarks{
+				ThresholdEnabled: true,
+				Low:              "85%",
+				High:             "90%",
+			},
+			expected: &diskCapacity{
+				required:      300,
+				availableLow:  250,
+				availableHigh: 320,
+			},
+		},
+		{
+			watermarks: &types.DiskWatermarks{
+				ThresholdEnabled: true,
+				Low:              "200b",
+				High:             "100b",
+			},
+			expected: &diskCapacity{
+				required:      300,
+				availableLow:  200,
+				availableHigh: 320,
+			},
+		},
+		{
+			watermarks: &types.DiskWatermarks{
+				ThresholdEnabled: false,
+				Low:              "85%",
+				High:             "90%",
+			},
+			expected: &diskCapacity{
+				required:      300,
+				availableLow:  520,
+				availableHigh: 520,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc.expected.watermarks = tc.watermarks
+
+		got, err := estimateDiskCapacity(allocations, nodes, exclude, []string{"node-a"}, tc.watermarks)
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+		}
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("disk capacity does not match. expected: %#v, got: %#v", tc.expected, got)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	testcases := []struct {
+		bytes    int64
+		expected string
+	}{
+		{
+			bytes:    512,
+			expected: "512b",
+		},
+		{
+			bytes:    1536,
+			expected: "1.5kb",
+		},
+		{
+			bytes:    10 * (1 << 30),
+			expected: "10.0gb",
+		},
+	}
+
+	for _, tc := range testcases {
+		if got := formatBytes(tc.bytes); got != tc.expected {
+			t.Errorf("formatted bytes does not match. expected: %q, got: %q", tc.expected, got)
+		}
+	}
+}
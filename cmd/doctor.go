@@ -0,0 +1,433 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/dtan4/esnctl/aws"
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	severityCritical = "critical"
+	severityWarning  = "warning"
+	severityInfo     = "info"
+)
+
+// severityOrder is the order discrepancies are reported in
+var severityOrder = map[string]int{
+	severityCritical: 0,
+	severityWarning:  1,
+	severityInfo:     2,
+}
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	Use:           "doctor",
+	Short:         "Check consistency between Elasticsearch cluster, ASG and target group",
+	RunE:          doDoctor,
+}
+
+var doctorOpts = struct {
+	autoScalingGroup string
+	clusterURL       string
+	fix              bool
+	region           string
+}{}
+
+// discrepancy represents inconsistency found by doctor command
+type discrepancy struct {
+	Severity string `json:"severity" yaml:"severity"`
+	Check    string `json:"check" yaml:"check"`
+	Message  string `json:"message" yaml:"message"`
+	// Remediation describes what --fix does, and is empty if the discrepancy cannot be fixed safely
+	Remediation string `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+	Fixed       bool   `json:"fixed,omitempty" yaml:"fixed,omitempty"`
+
+	fix func(ctx context.Context, r *remediator) error
+}
+
+// remediator holds API clients used to fix discrepancies
+type remediator struct {
+	client es.Client
+}
+
+// loadBalancerHealth represents a load balancer attached to the ASG and health state of instances registered to it
+type loadBalancerHealth struct {
+	lb aws.LoadBalancer
+	// health is keyed by instance ID
+	health map[string]string
+}
+
+// clusterSnapshot represents Elasticsearch cluster, ASG and load balancers looked up by doctor command
+type clusterSnapshot struct {
+	group string
+	nodes []*types.Node
+	// instanceIDs are the IDs of EC2 instances running nodes, keyed by node name
+	// Nodes whose instance cannot be found are not included
+	instanceIDs    map[string]string
+	groupInstances []string
+	loadBalancers  []*loadBalancerHealth
+	allocation     *types.AllocationSettings
+}
+
+func doDoctor(cmd *cobra.Command, args []string) error {
+	if doctorOpts.clusterURL == "" {
+		return errors.New("Elasticsearch cluster URL (--cluster-url) must be specified")
+	}
+
+	if doctorOpts.autoScalingGroup == "" {
+		return errors.New("Auto Scaling Group (--group) must be specified")
+	}
+
+	ctx, stopHandlingSignals := newSignalContext()
+	defer stopHandlingSignals()
+
+	if err := aws.Initialize(doctorOpts.region); err != nil {
+		return errors.Wrap(err, "failed to initialize AWS service clients")
+	}
+
+	httpClient := &http.Client{}
+
+	client, err := es.New(doctorOpts.clusterURL, httpClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	snapshot, err := takeClusterSnapshot(ctx, client, doctorOpts.autoScalingGroup)
+	if err != nil {
+		return err
+	}
+
+	discrepancies := diagnose(snapshot)
+
+	if doctorOpts.fix {
+		r := &remediator{
+			client: client,
+		}
+
+		for _, d := range discrepancies {
+			if d.fix == nil {
+				continue
+			}
+
+			log.Printf("===> Fixing: %s...\n", d.Remediation)
+
+			if err := d.fix(ctx, r); err != nil {
+				log.Printf("===> Warning: failed to fix %s: %s\n", d.Check, err)
+				continue
+			}
+
+			d.Fixed = true
+		}
+	}
+
+	if structuredOutput() {
+		if err := printObject(discrepancies); err != nil {
+			return err
+		}
+	} else {
+		printDiscrepancies(discrepancies)
+	}
+
+	remaining := 0
+
+	for _, d := range discrepancies {
+		if !d.Fixed && d.Severity != severityInfo {
+			remaining++
+		}
+	}
+
+	if remaining > 0 {
+		return errors.Errorf("%d discrepancies remain", remaining)
+	}
+
+	return nil
+}
+
+// takeClusterSnapshot looks up Elasticsearch cluster, the given ASG and load balancers attached to it
+func takeClusterSnapshot(ctx context.Context, client es.Client, group string) (*clusterSnapshot, error) {
+	nodes, err := client.DescribeNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe Elasticsearch nodes")
+	}
+
+	allocation, err := client.AllocationSettings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve allocation settings")
+	}
+
+	instanceIDs := map[string]string{}
+
+	for _, node := range nodes {
+		instanceID, err := nodeInstanceID(ctx, node)
+		if err != nil {
+			// Reported as a discrepancy of the node, so that other checks can still run
+			continue
+		}
+
+		instanceIDs[node.Name] = instanceID
+	}
+
+	groupInstances, err := aws.AutoScaling.ListInstances(ctx, group)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list instances in Auto Scaling Group")
+	}
+
+	snapshot := &clusterSnapshot{
+		group:          group,
+		nodes:          nodes,
+		instanceIDs:    instanceIDs,
+		groupInstances: groupInstances,
+		allocation:     allocation,
+	}
+
+	lbs, err := aws.RetrieveLoadBalancers(ctx, group)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve load balancers")
+	}
+
+	for _, lb := range lbs {
+		health, err := lb.ListInstanceHealth(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to retrieve health of instances registered to %s", lb.ID())
+		}
+
+		snapshot.loadBalancers = append(snapshot.loadBalancers, &loadBalancerHealth{
+			lb:     lb,
+			health: health,
+		})
+	}
+
+	return snapshot, nil
+}
+
+// diagnose cross-checks the given snapshot and returns discrepancies ordered by severity
+func diagnose(s *clusterSnapshot) []*discrepancy {
+	discrepancies := []*discrepancy{}
+
+	joined := []string{}
+	nodeNames := map[string]string{}
+
+	for _, node := range s.nodes {
+		instanceID, ok := s.instanceIDs[node.Name]
+		if !ok {
+			discrepancies = append(discrepancies, &discrepancy{
+				Severity: severityWarning,
+				Check:    "node-instance-unknown",
+				Message:  fmt.Sprintf("EC2 instance running node %q cannot be found", node.Name),
+			})
+
+			continue
+		}
+
+		joined = append(joined, instanceID)
+		nodeNames[instanceID] = node.Name
+
+		if !contains(s.groupInstances, instanceID) {
+			discrepancies = append(discrepancies, &discrepancy{
+				Severity: severityWarning,
+				Check:    "node-not-in-group",
+				Message:  fmt.Sprintf("node %q (%s) is not in Auto Scaling Group %q", node.Name, instanceID, s.group),
+			})
+		}
+	}
+
+	for _, instanceID := range s.groupInstances {
+		if !contains(joined, instanceID) {
+			discrepancies = append(discrepancies, &discrepancy{
+				Severity: severityWarning,
+				Check:    "instance-not-joined",
+				Message:  fmt.Sprintf("instance %s in Auto Scaling Group %q has not joined the cluster", instanceID, s.group),
+			})
+		}
+	}
+
+	for _, lb := range s.loadBalancers {
+		discrepancies = append(discrepancies, diagnoseLoadBalancer(s, lb, joined, nodeNames)...)
+	}
+
+	discrepancies = append(discrepancies, diagnoseAllocation(s)...)
+
+	sort.SliceStable(discrepancies, func(i, j int) bool {
+		return severityOrder[discrepancies[i].Severity] < severityOrder[discrepancies[j].Severity]
+	})
+
+	return discrepancies
+}
+
+// diagnoseLoadBalancer checks instances registered to the given load balancer against ASG instances joined to the cluster
+func diagnoseLoadBalancer(s *clusterSnapshot, lb *loadBalancerHealth, joined []string, nodeNames map[string]string) []*discrepancy {
+	discrepancies := []*discrepancy{}
+
+	targets := []string{}
+
+	for instanceID := range lb.health {
+		targets = append(targets, instanceID)
+	}
+
+	sort.Strings(targets)
+
+	for _, instanceID := range targets {
+		state := lb.health[instanceID]
+
+		if !contains(s.groupInstances, instanceID) {
+			discrepancies = append(discrepancies, &discrepancy{
+				Severity: severityWarning,
+				Check:    "target-not-in-group",
+				Message:  fmt.Sprintf("target %s (%s) of %s is not in Auto Scaling Group %q", instanceID, state, lb.lb.ID(), s.group),
+			})
+		}
+
+		// Target groups report healthy / unhealthy, and Classic Load Balancers report InService / OutOfService
+		switch state {
+		case "healthy", "InService":
+		case "unhealthy", "OutOfService":
+			discrepancies = append(discrepancies, &discrepancy{
+				Severity: severityCritical,
+				Check:    "target-unhealthy",
+				Message:  fmt.Sprintf("target %s of %s is %s", instanceID, lb.lb.ID(), state),
+			})
+		default:
+			// initial and draining are transient, which is fine unless they last
+			discrepancies = append(discrepancies, &discrepancy{
+				Severity: severityInfo,
+				Check:    "target-not-healthy",
+				Message:  fmt.Sprintf("target %s of %s is %s", instanceID, lb.lb.ID(), state),
+			})
+		}
+	}
+
+	for _, instanceID := range s.groupInstances {
+		if _, ok := lb.health[instanceID]; ok || !contains(joined, instanceID) {
+			continue
+		}
+
+		discrepancies = append(discrepancies, &discrepancy{
+			Severity:    severityWarning,
+			Check:       "target-not-registered",
+			Message:     fmt.Sprintf("node %q (%s) is not registered to %s", nodeNames[instanceID], instanceID, lb.lb.ID()),
+			Remediation: fmt.Sprintf("register %s to %s", instanceID, lb.lb.ID()),
+			fix:         registerTargetFix(lb.lb, instanceID),
+		})
+	}
+
+	return discrepancies
+}
+
+// diagnoseAllocation checks allocation settings left behind by aborted runs
+func diagnoseAllocation(s *clusterSnapshot) []*discrepancy {
+	discrepancies := []*discrepancy{}
+
+	if s.allocation.Enable != "all" {
+		discrepancies = append(discrepancies, &discrepancy{
+			Severity:    severityCritical,
+			Check:       "allocation-disabled",
+			Message:     fmt.Sprintf("shard allocation is restricted (cluster.routing.allocation.enable: %s)", s.allocation.Enable),
+			Remediation: "enable shard allocation",
+			fix: func(ctx context.Context, r *remediator) error {
+				return r.client.EnableReallocation(ctx)
+			},
+		})
+	}
+
+	attributes := []string{}
+
+	for attribute := range s.allocation.Exclude {
+		attributes = append(attributes, attribute)
+	}
+
+	sort.Strings(attributes)
+
+	for _, attribute := range attributes {
+		for _, value := range s.allocation.Exclude[attribute] {
+			var excluded *types.Node
+
+			for _, node := range s.nodes {
+				if node.AllocationFilterValue(attribute) == value {
+					excluded = node
+					break
+				}
+			}
+
+			if excluded == nil {
+				discrepancies = append(discrepancies, &discrepancy{
+					Severity:    severityWarning,
+					Check:       "allocation-exclude-stale",
+					Message:     fmt.Sprintf("cluster.routing.allocation.exclude.%s has %q, which matches no node", attribute, value),
+					Remediation: fmt.Sprintf("remove %q from cluster.routing.allocation.exclude.%s", value, attribute),
+					fix:         includeInAllocationFix(attribute, value),
+				})
+
+				continue
+			}
+
+			// Excluded node may be being drained by a run in progress, so it is not fixed automatically
+			discrepancies = append(discrepancies, &discrepancy{
+				Severity: severityWarning,
+				Check:    "allocation-exclude-node",
+				Message: fmt.Sprintf("node %q is excluded from shard allocation by cluster.routing.allocation.exclude.%s. Resume or roll back the run removing it",
+					excluded.Name, attribute),
+			})
+		}
+	}
+
+	return discrepancies
+}
+
+// registerTargetFix returns the fix registering the given instance to the given load balancer
+func registerTargetFix(lb aws.LoadBalancer, instanceID string) func(ctx context.Context, r *remediator) error {
+	return func(ctx context.Context, r *remediator) error {
+		return lb.RegisterInstances(ctx, []string{instanceID})
+	}
+}
+
+// includeInAllocationFix returns the fix removing the given value from allocation exclude setting
+func includeInAllocationFix(attribute, value string) func(ctx context.Context, r *remediator) error {
+	return func(ctx context.Context, r *remediator) error {
+		return r.client.IncludeNodesInAllocation(ctx, attribute, []string{value})
+	}
+}
+
+// printDiscrepancies prints the given discrepancies with their severity and remediation
+func printDiscrepancies(discrepancies []*discrepancy) {
+	if len(discrepancies) == 0 {
+		fmt.Println("No discrepancy found")
+		return
+	}
+
+	fixable := 0
+
+	for _, d := range discrepancies {
+		fmt.Printf("[%s] %s\n", d.Severity, d.Message)
+
+		switch {
+		case d.Fixed:
+			fmt.Printf("    fixed: %s\n", d.Remediation)
+		case d.Remediation != "":
+			fmt.Printf("    fix: %s\n", d.Remediation)
+			fixable++
+		}
+	}
+
+	if fixable > 0 {
+		fmt.Printf("\n%d of %d discrepancies can be fixed with --fix\n", fixable, len(discrepancies))
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().StringVar(&doctorOpts.autoScalingGroup, "group", "", "Auto Scaling Group")
+	doctorCmd.Flags().StringVar(&doctorOpts.clusterURL, "cluster-url", "", "Elasticsearch cluster URL")
+	doctorCmd.Flags().BoolVar(&doctorOpts.fix, "fix", false, "Fix discrepancies which are safe to fix")
+	doctorCmd.Flags().StringVar(&doctorOpts.region, "region", "", "AWS region")
+}
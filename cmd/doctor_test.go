@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/dtan4/esnctl/aws"
+	"github.com/dtan4/esnctl/es/types"
+)
+
+func TestDiagnose(t *testing.T) {
+	targetGroup, err := aws.NewLoadBalancer(aws.LoadBalancerKindTargetGroup, "arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab")
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	classic, err := aws.NewLoadBalancer(aws.LoadBalancerKindClassic, "elasticsearch")
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	snapshot := &clusterSnapshot{
+		group: "elasticsearch",
+		nodes: []*types.Node{
+			&types.Node{Name: "node-a", IP: "10.0.1.21"},
+			&types.Node{Name: "node-b", IP: "10.0.1.22"},
+			&types.Node{Name: "node-c", IP: "10.0.1.23"},
+			&types.Node{Name: "node-d", IP: "10.0.1.24"},
+			&types.Node{Name: "node-e", IP: "10.0.1.25"},
+		},
+		instanceIDs: map[string]string{
+			"node-a": "i-0000000a",
+			"node-b": "i-0000000b",
+			"node-c": "i-0000000c",
+			"node-d": "i-0000000d",
+		},
+		groupInstances: []string{"i-0000000a", "i-0000000b", "i-0000000c", "i-0000000f"},
+		loadBalancers: []*loadBalancerHealth{
+			&loadBalancerHealth{
+				lb: targetGroup,
+				health: map[string]string{
+					"i-0000000a": "healthy",
+					"i-0000000b": "unhealthy",
+					"i-0000000d": "draining",
+				},
+			},
+			&loadBalancerHealth{
+				lb: classic,
+				health: map[string]string{
+					"i-0000000a": "InService",
+					"i-0000000b": "InService",
+					"i-0000000c": "OutOfService",
+				},
+			},
+		},
+		allocation: &types.AllocationSettings{
+			Enable: "primaries",
+			Exclude: map[string][]string{
+				"_name": []string{"node-c", "node-z"},
+			},
+		},
+	}
+
+	expected := []struct {
+		severity string
+		check    string
+		message  string
+		fixable  bool
+	}{
+		{severityCritical, "target-unhealthy", "target i-0000000b of arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab is unhealthy", false},
+		{severityCritical, "target-unhealthy", "target i-0000000c of elasticsearch is OutOfService", false},
+		{severityCritical, "allocation-disabled", "shard allocation is restricted (cluster.routing.allocation.enable: primaries)", true},
+		{severityWarning, "node-not-in-group", `node "node-d" (i-0000000d) is not in Auto Scaling Group "elasticsearch"`, false},
+		{severityWarning, "node-instance-unknown", `EC2 instance running node "node-e" cannot be found`, false},
+		{severityWarning, "instance-not-joined", `instance i-0000000f in Auto Scaling Group "elasticsearch" has not joined the cluster`, false},
+		{severityWarning, "target-not-in-group", `target i-0000000d (draining) of arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab is not in Auto Scaling Group "elasticsearch"`, false},
+		{severityWarning, "target-not-registered", `node "node-c" (i-0000000c) is not registered to arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab`, true},
+		{severityWarning, "allocation-exclude-node", `node "node-c" is excluded from shard allocation by cluster.routing.allocation.exclude._name. Resume or roll back the run removing it`, false},
+		{severityWarning, "allocation-exclude-stale", `cluster.routing.allocation.exclude._name has "node-z", which matches no node`, true},
+		{severityInfo, "target-not-healthy", "target i-0000000d of arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab is draining", false},
+	}
+
+	got := diagnose(snapshot)
+
+	if len(got) != len(expected) {
+		for _, d := range got {
+			t.Logf("%+v", d)
+		}
+
+		t.Fatalf("number of discrepancies does not match. expected: %d, got: %d", len(expected), len(got))
+	}
+
+	for i, e := range expected {
+		d := got[i]
+
+		if d.Severity != e.severity || d.Check != e.check || d.Message != e.message {
+			t.Errorf("discrepancy does not match. expected: %+v, got: %+v", e, d)
+		}
+
+		if (d.fix != nil) != e.fixable || (d.Remediation != "") != e.fixable {
+			t.Errorf("fixable of %s does not match. expected: %t, got: %+v", d.Check, e.fixable, d)
+		}
+	}
+}
+
+func TestDiagnose_consistent(t *testing.T) {
+	snapshot := &clusterSnapshot{
+		group: "elasticsearch",
+		nodes: []*types.Node{
+			&types.Node{Name: "node-a", IP: "10.0.1.21"},
+		},
+		instanceIDs: map[string]string{
+			"node-a": "i-0000000a",
+		},
+		groupInstances: []string{"i-0000000a"},
+		allocation: &types.AllocationSettings{
+			Enable:  "all",
+			Exclude: map[string][]string{},
+		},
+	}
+
+	if got := diagnose(snapshot); len(got) != 0 {
+		t.Errorf("no discrepancy should be found, got: %+v", got)
+	}
+}
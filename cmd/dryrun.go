@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// recordingTransport passes read-only requests through and records the others without sending them
+type recordingTransport struct {
+	transport http.RoundTripper
+	requests  []string
+}
+
+func newDryRunHTTPClient() (*http.Client, *recordingTransport) {
+	transport := &recordingTransport{
+		transport: http.DefaultTransport,
+		requests:  []string{},
+	}
+
+	return &http.Client{Transport: transport}, transport
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == "GET" || req.Method == "HEAD" {
+		return t.transport.RoundTrip(req)
+	}
+
+	request := fmt.Sprintf("%s %s", req.Method, req.URL.RequestURI())
+
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+
+		if len(body) > 0 {
+			request += " " + string(body)
+		}
+	}
+
+	t.requests = append(t.requests, request)
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"acknowledged":true}`)),
+		Request:    req,
+	}, nil
+}
+
+// take returns the recorded requests and clears them
+func (t *recordingTransport) take() []string {
+	requests := t.requests
+	t.requests = []string{}
+
+	return requests
+}
+
+func printPlanStep(n int, description string, details []string) {
+	fmt.Printf("  %d. %s\n", n, description)
+
+	for _, detail := range details {
+		fmt.Printf("     %s\n", detail)
+	}
+}
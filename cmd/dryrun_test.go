@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRecordingTransport(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:9200").Get("/_cat/shards").Reply(200).BodyString("wiki1 0 p STARTED 3014 31.1mb 192.168.56.10 node1")
+
+	client, transport := newDryRunHTTPClient()
+	transport.transport = gock.DefaultTransport
+
+	resp, err := client.Get("http://example.com:9200/_cat/shards")
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+	resp.Body.Close()
+
+	req, err := http.NewRequest("PUT", "http://example.com:9200/_cluster/settings", strings.NewReader(`{"transient":{"cluster.routing.allocation.enable":"none"}}`))
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status code does not match. expected: 200, got: %d", resp.StatusCode)
+	}
+
+	expected := []string{
+		`PUT /_cluster/settings {"transient":{"cluster.routing.allocation.enable":"none"}}`,
+	}
+
+	if got := transport.take(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("recorded requests does not match. expected: %q, got: %q", expected, got)
+	}
+
+	if got := transport.take(); len(got) != 0 {
+		t.Errorf("recorded requests should be cleared. got: %q", got)
+	}
+}
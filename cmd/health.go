@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultHealthTimeout = 10 * time.Minute
+)
+
+// checkClusterHealth refuses to start operations unless the cluster is green
+// The check is skipped if force is true
+func checkClusterHealth(ctx context.Context, client es.Client, force bool) error {
+	health, err := client.ClusterHealth(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve cluster health")
+	}
+
+	if health.IsGreen() || force {
+		return nil
+	}
+
+	return errors.Errorf("cluster is not healthy (status: %s, relocating: %d, initializing: %d, unassigned: %d). Use --force to proceed anyway",
+		health.Status, health.RelocatingShards, health.InitializingShards, health.UnassignedShards)
+}
+
+func checkClusterHealthStep(client es.Client, force bool) step {
+	return step{
+		name:        "check-cluster-health",
+		description: "Checking cluster health",
+		run: func(ctx context.Context) error {
+			return checkClusterHealth(ctx, client, force)
+		},
+	}
+}
+
+func waitClusterGreenStep(client es.Client, prefix string, wait waitConfig) step {
+	return step{
+		name:        prefix + "wait-cluster-green",
+		description: "Waiting for cluster health to be green",
+		run: func(ctx context.Context) error {
+			return waitClusterGreen(ctx, client, wait)
+		},
+	}
+}
+
+// waitClusterGreen waits until the cluster becomes green and no shard is relocating or initializing
+func waitClusterGreen(ctx context.Context, client es.Client, wait waitConfig) error {
+	var health *types.ClusterHealth
+
+	err := wait.waitUntil(ctx, wait.healthTimeout, func() (bool, error) {
+		h, err := client.ClusterHealth(ctx)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to retrieve cluster health")
+		}
+
+		health = h
+
+		return health.IsGreen(), nil
+	})
+	if err == errWaitTimeout {
+		return errors.Errorf("timed out: cluster does not become green within %s (status: %s, relocating: %d, initializing: %d)",
+			wait.healthTimeout, health.Status, health.RelocatingShards, health.InitializingShards)
+	}
+
+	return err
+}
@@ -1,10 +1,18 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"sort"
+	"strings"
+	"text/tabwriter"
 
+	"github.com/dtan4/esnctl/aws"
 	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -20,13 +28,36 @@ var listCmd = &cobra.Command{
 
 var listOpts = struct {
 	clusterURL string
+	region     string
+	role       string
 }{}
 
+// listedNode represents node printed by list command, joined with EC2 instance running it
+// AWS fields are empty if they could not be looked up
+type listedNode struct {
+	Name             string   `json:"name" yaml:"name"`
+	ID               string   `json:"id" yaml:"id"`
+	IP               string   `json:"ip" yaml:"ip"`
+	Roles            []string `json:"roles" yaml:"roles"`
+	InstanceID       string   `json:"instance_id,omitempty" yaml:"instance_id,omitempty"`
+	AvailabilityZone string   `json:"availability_zone,omitempty" yaml:"availability_zone,omitempty"`
+	InstanceType     string   `json:"instance_type,omitempty" yaml:"instance_type,omitempty"`
+	Group            string   `json:"group,omitempty" yaml:"group,omitempty"`
+	TargetHealth     string   `json:"target_health,omitempty" yaml:"target_health,omitempty"`
+	Shards           int      `json:"shards" yaml:"shards"`
+	DiskUsedBytes    int64    `json:"disk_used_bytes" yaml:"disk_used_bytes"`
+	DiskTotalBytes   int64    `json:"disk_total_bytes" yaml:"disk_total_bytes"`
+	HeapUsedPercent  int      `json:"heap_used_percent" yaml:"heap_used_percent"`
+}
+
 func doList(cmd *cobra.Command, args []string) error {
 	if listOpts.clusterURL == "" {
 		return errors.New("Elasticsearch cluster (--cluster-url) must be specified")
 	}
 
+	ctx, stopHandlingSignals := newSignalContext()
+	defer stopHandlingSignals()
+
 	httpClient := &http.Client{}
 
 	client, err := es.New(listOpts.clusterURL, httpClient)
@@ -34,20 +65,209 @@ func doList(cmd *cobra.Command, args []string) error {
 		return errors.Wrap(err, "failed to create Elasitcsearch API client")
 	}
 
-	nodes, err := client.ListNodes()
+	nodes, err := client.DescribeNodes(ctx)
 	if err != nil {
-		return errors.Wrap(err, "failed to list Elasticsearch nodes")
+		return errors.Wrap(err, "failed to describe Elasticsearch nodes")
 	}
 
+	nodes = filterNodesByRole(nodes, listOpts.role)
+
+	allocations, err := client.ListAllocations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list shard allocations")
+	}
+
+	stats, err := client.ListNodeStats(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve node stats")
+	}
+
+	listed := joinNodes(nodes, allocations, stats)
+
+	// AWS is looked up on a best-effort basis, so that nodes can be listed even without AWS access
+	if err := aws.Initialize(listOpts.region); err != nil {
+		log.Printf("===> Warning: %s\n", errors.Wrap(err, "failed to initialize AWS service clients"))
+	} else if err := correlateInstances(ctx, listed, nodes); err != nil {
+		log.Printf("===> Warning: %s\n", err)
+	}
+
+	if structuredOutput() {
+		return printObject(listed)
+	}
+
+	return printNodeTable(stdout, listed)
+}
+
+// joinNodes joins the given nodes with their shard allocations and stats by node name
+func joinNodes(nodes []*types.Node, allocations []*types.Allocation, stats []*types.NodeStats) []*listedNode {
+	listed := []*listedNode{}
+
 	for _, node := range nodes {
-		fmt.Println(node)
+		n := &listedNode{
+			Name:  node.Name,
+			ID:    node.ID,
+			IP:    node.IP,
+			Roles: node.Roles,
+		}
+
+		for _, allocation := range allocations {
+			if allocation.NodeName == node.Name {
+				n.Shards = allocation.Shards
+				n.DiskUsedBytes = allocation.DiskUsedBytes
+				n.DiskTotalBytes = allocation.DiskTotalBytes
+				break
+			}
+		}
+
+		for _, s := range stats {
+			if s.NodeName == node.Name {
+				n.HeapUsedPercent = s.HeapUsedPercent
+				break
+			}
+		}
+
+		listed = append(listed, n)
+	}
+
+	return listed
+}
+
+// correlateInstances fills the given nodes with EC2 instances running them, their ASGs and target group health
+// nodes must be in the same order as listed
+func correlateInstances(ctx context.Context, listed []*listedNode, nodes []*types.Node) error {
+	byInstanceID := map[string]*listedNode{}
+	instanceIDs := []string{}
+
+	for i, node := range nodes {
+		instanceID, err := nodeInstanceID(ctx, node)
+		if err != nil {
+			// Node may not be running on EC2, and other nodes can still be looked up
+			log.Printf("===> Warning: %s\n", err)
+			continue
+		}
+
+		listed[i].InstanceID = instanceID
+		byInstanceID[instanceID] = listed[i]
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+
+	instances, err := aws.EC2.DescribeInstances(ctx, instanceIDs)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe EC2 instances")
+	}
+
+	for _, instance := range instances {
+		if n, ok := byInstanceID[instance.ID]; ok {
+			n.AvailabilityZone = instance.AvailabilityZone
+			n.InstanceType = instance.InstanceType
+		}
+	}
+
+	groups, err := aws.AutoScaling.RetrieveInstanceGroups(ctx, instanceIDs)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve Auto Scaling Groups of instances")
+	}
+
+	groupNames := []string{}
+
+	for instanceID, group := range groups {
+		if n, ok := byInstanceID[instanceID]; ok {
+			n.Group = group
+		}
+
+		if !contains(groupNames, group) {
+			groupNames = append(groupNames, group)
+		}
+	}
+
+	sort.Strings(groupNames)
+
+	for _, group := range groupNames {
+		lbs, err := aws.RetrieveLoadBalancers(ctx, group)
+		if err != nil {
+			// Nodes in other ASGs can still be looked up
+			log.Printf("===> Warning: %s\n", errors.Wrapf(err, "failed to retrieve load balancers of %q", group))
+			continue
+		}
+
+		for _, lb := range lbs {
+			health, err := lb.ListInstanceHealth(ctx)
+			if err != nil {
+				return errors.Wrapf(err, "failed to retrieve health of instances registered to %s", lb.ID())
+			}
+
+			for instanceID, state := range health {
+				n, ok := byInstanceID[instanceID]
+				if !ok || n.Group != group {
+					continue
+				}
+
+				// Health states in multiple load balancers are listed in the order of load balancers
+				if n.TargetHealth == "" {
+					n.TargetHealth = state
+				} else {
+					n.TargetHealth += "," + state
+				}
+			}
+		}
 	}
 
 	return nil
 }
 
+// nodeInstanceID returns ID of EC2 instance running the given node
+// aws_instance_id node attribute is used if set. Otherwise the instance is looked up by private IP
+func nodeInstanceID(ctx context.Context, node *types.Node) (string, error) {
+	if instanceID := node.Attributes["aws_instance_id"]; instanceID != "" {
+		return instanceID, nil
+	}
+
+	instanceID, err := aws.EC2.RetrieveInstanceIDFromPrivateIP(ctx, node.IP)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to retrieve instance ID of %q", node.Name)
+	}
+
+	return instanceID, nil
+}
+
+// printNodeTable prints the given nodes as a table
+// Values not looked up are printed as "-"
+func printNodeTable(w io.Writer, listed []*listedNode) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "NAME\tROLES\tIP\tINSTANCE\tAZ\tTYPE\tGROUP\tTARGET\tSHARDS\tDISK\tHEAP")
+
+	for _, n := range listed {
+		disk := "-"
+		if n.DiskTotalBytes > 0 {
+			disk = fmt.Sprintf("%s/%s", formatBytes(n.DiskUsedBytes), formatBytes(n.DiskTotalBytes))
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\t%d%%\n",
+			n.Name, orDash(strings.Join(n.Roles, ",")), orDash(n.IP), orDash(n.InstanceID), orDash(n.AvailabilityZone),
+			orDash(n.InstanceType), orDash(n.Group), orDash(n.TargetHealth), n.Shards, disk, n.HeapUsedPercent)
+	}
+
+	return tw.Flush()
+}
+
+// orDash returns "-" if the given value is empty
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
+
 func init() {
 	RootCmd.AddCommand(listCmd)
 
 	listCmd.Flags().StringVar(&listOpts.clusterURL, "cluster-url", "", "Elasticsearch cluster URL")
+	listCmd.Flags().StringVar(&listOpts.region, "region", "", "AWS region")
+	listCmd.Flags().StringVar(&listOpts.role, "role", "", "List only nodes having the given role, e.g. data or master")
 }
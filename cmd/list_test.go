@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/dtan4/esnctl/es/types"
+)
+
+func TestJoinNodes(t *testing.T) {
+	nodes := []*types.Node{
+		&types.Node{
+			ID:    "Ab1cD2eFG3hIJ4kLMnOpQr",
+			Name:  "node-a",
+			IP:    "10.0.1.23",
+			Roles: []string{"master", "data"},
+		},
+		&types.Node{
+			ID:    "Zy9xW8vUT7sRQ6pONmLkJi",
+			Name:  "node-b",
+			IP:    "10.0.1.24",
+			Roles: []string{"master"},
+		},
+	}
+
+	allocations := []*types.Allocation{
+		&types.Allocation{
+			NodeName:           "node-a",
+			Shards:             12,
+			DiskUsedBytes:      10 << 30,
+			DiskAvailableBytes: 40 << 30,
+			DiskTotalBytes:     50 << 30,
+		},
+	}
+
+	stats := []*types.NodeStats{
+		&types.NodeStats{
+			NodeName:        "node-b",
+			HeapUsedPercent: 30,
+		},
+		&types.NodeStats{
+			NodeName:        "node-a",
+			HeapUsedPercent: 75,
+		},
+	}
+
+	expected := []*listedNode{
+		&listedNode{
+			Name:            "node-a",
+			ID:              "Ab1cD2eFG3hIJ4kLMnOpQr",
+			IP:              "10.0.1.23",
+			Roles:           []string{"master", "data"},
+			Shards:          12,
+			DiskUsedBytes:   10 << 30,
+			DiskTotalBytes:  50 << 30,
+			HeapUsedPercent: 75,
+		},
+		&listedNode{
+			Name:            "node-b",
+			ID:              "Zy9xW8vUT7sRQ6pONmLkJi",
+			IP:              "10.0.1.24",
+			Roles:           []string{"master"},
+			HeapUsedPercent: 30,
+		},
+	}
+
+	if got := joinNodes(nodes, allocations, stats); !reflect.DeepEqual(got, expected) {
+		t.Errorf("nodes does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestPrintNodeTable(t *testing.T) {
+	listed := []*listedNode{
+		&listedNode{
+			Name:             "node-a",
+			IP:               "10.0.1.23",
+			Roles:            []string{"master", "data"},
+			InstanceID:       "i-1234abcd",
+			AvailabilityZone: "ap-northeast-1a",
+			InstanceType:     "r5.xlarge",
+			Group:            "elasticsearch",
+			TargetHealth:     "healthy",
+			Shards:           12,
+			DiskUsedBytes:    10 << 30,
+			DiskTotalBytes:   50 << 30,
+			HeapUsedPercent:  75,
+		},
+		&listedNode{
+			Name:            "node-b",
+			IP:              "10.0.1.24",
+			Roles:           []string{"master"},
+			HeapUsedPercent: 30,
+		},
+	}
+
+	expected := `NAME    ROLES        IP         INSTANCE    AZ               TYPE       GROUP          TARGET   SHARDS  DISK           HEAP
+node-a  master,data  10.0.1.23  i-1234abcd  ap-northeast-1a  r5.xlarge  elasticsearch  healthy  12      10.0gb/50.0gb  75%
+node-b  master       10.0.1.24  -           -                -          -              -        0       -              30%
+`
+
+	buf := &bytes.Buffer{}
+
+	if err := printNodeTable(buf, listed); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if got := buf.String(); got != expected {
+		t.Errorf("table does not match. expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/dtan4/esnctl/state"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+const (
+	stepStatusStarted     = "started"
+	stepStatusCompleted   = "completed"
+	stepStatusSkipped     = "skipped"
+	stepStatusFailed      = "failed"
+	stepStatusProgress    = "progress"
+	stepStatusRollingBack = "rolling_back"
+	stepStatusRolledBack  = "rolled_back"
+)
+
+// stdout is where command output is written. Replaced in tests
+var stdout io.Writer = os.Stdout
+
+// stepEvent represents a change of step status, printed by commands running steps with --output json / yaml
+type stepEvent struct {
+	RunID       string            `json:"run_id" yaml:"run_id"`
+	Step        string            `json:"step" yaml:"step"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Status      string            `json:"status" yaml:"status"`
+	Time        string            `json:"time" yaml:"time"`
+	StartedAt   string            `json:"started_at,omitempty" yaml:"started_at,omitempty"`
+	Details     map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Error       string            `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// validateOutput returns error if --output is unknown, or cannot be used with the given command
+func validateOutput(cmd *cobra.Command, args []string) error {
+	switch rootOpts.output {
+	case outputText:
+		return nil
+	case outputJSON, outputYAML:
+	default:
+		return errors.Errorf("unknown output format %q, must be one of text, json or yaml", rootOpts.output)
+	}
+
+	if f := cmd.Flags().Lookup("dry-run"); f != nil && f.Changed {
+		return errors.Errorf("--dry-run does not support --output %s", rootOpts.output)
+	}
+
+	return nil
+}
+
+// structuredOutput returns whether --output is json or yaml
+// Human-readable output to stdout, such as dots and progress bar, must be suppressed then
+func structuredOutput() bool {
+	return rootOpts.output == outputJSON || rootOpts.output == outputYAML
+}
+
+// printObject prints the given object in the format of --output
+// JSON is printed in one line, so that a stream of objects can be read line by line
+func printObject(v interface{}) error {
+	switch rootOpts.output {
+	case outputJSON:
+		body, err := json.Marshal(v)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal output to JSON")
+		}
+
+		fmt.Fprintf(stdout, "%s\n", body)
+	case outputYAML:
+		body, err := yaml.Marshal(v)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal output to YAML")
+		}
+
+		fmt.Fprintf(stdout, "---\n%s", body)
+	default:
+		return errors.Errorf("output format %q cannot print objects", rootOpts.output)
+	}
+
+	return nil
+}
+
+// emitStepEvent prints the event of the given step if --output is json or yaml
+// startedAt is omitted if it is zero
+func emitStepEvent(run *state.Run, s step, status string, startedAt time.Time, details map[string]string, err error) {
+	if !structuredOutput() {
+		return
+	}
+
+	event := stepEvent{
+		RunID:       run.ID,
+		Step:        s.name,
+		Description: s.description,
+		Status:      status,
+		Time:        time.Now().Format(time.RFC3339),
+		Details:     details,
+	}
+
+	if !startedAt.IsZero() {
+		event.StartedAt = startedAt.Format(time.RFC3339)
+	}
+
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	// Events are best effort, and must not stop the run
+	printObject(event)
+}
+
+// changedValues returns the values of the run set or modified since the given snapshot
+// Values recorded by a step, e.g. desired capacity, are reported as details of the step
+func changedValues(before, after map[string]string) map[string]string {
+	var changed map[string]string
+
+	for key, value := range after {
+		if before[key] == value {
+			continue
+		}
+
+		if changed == nil {
+			changed = map[string]string{}
+		}
+
+		changed[key] = value
+	}
+
+	return changed
+}
+
+// copyValues returns a snapshot of the given values
+func copyValues(values map[string]string) map[string]string {
+	copied := map[string]string{}
+
+	for key, value := range values {
+		copied[key] = value
+	}
+
+	return copied
+}
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dtan4/esnctl/state"
+	"github.com/pkg/errors"
+)
+
+// captureOutput sets --output and captures objects printed while f runs
+func captureOutput(output string, f func()) string {
+	defer func(o string) { rootOpts.output = o }(rootOpts.output)
+	defer func() { stdout = os.Stdout }()
+
+	buf := &bytes.Buffer{}
+	stdout = buf
+	rootOpts.output = output
+
+	f()
+
+	return buf.String()
+}
+
+func TestPrintObject(t *testing.T) {
+	v := &listedNode{
+		Name:  "node-a",
+		ID:    "Ab1cD2eFG3hIJ4kLMnOpQr",
+		IP:    "10.0.1.23",
+		Roles: []string{"master", "data"},
+	}
+
+	testcases := []struct {
+		output   string
+		expected string
+	}{
+		{
+			output:   outputJSON,
+			expected: `{"name":"node-a","id":"Ab1cD2eFG3hIJ4kLMnOpQr","ip":"10.0.1.23","roles":["master","data"],"shards":0,"disk_used_bytes":0,"disk_total_bytes":0,"heap_used_percent":0}` + "\n",
+		},
+		{
+			output: outputYAML,
+			expected: `---
+name: node-a
+id: Ab1cD2eFG3hIJ4kLMnOpQr
+ip: 10.0.1.23
+roles:
+- master
+- data
+shards: 0
+disk_used_bytes: 0
+disk_total_bytes: 0
+heap_used_percent: 0
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		got := captureOutput(tc.output, func() {
+			if err := printObject(v); err != nil {
+				t.Errorf("error should not be raised: %s", err)
+			}
+		})
+
+		if got != tc.expected {
+			t.Errorf("output does not match. expected: %q, got: %q", tc.expected, got)
+		}
+	}
+}
+
+func TestChangedValues(t *testing.T) {
+	before := map[string]string{
+		"group":            "elasticsearch",
+		"desired_capacity": "",
+	}
+
+	after := map[string]string{
+		"group":             "elasticsearch",
+		"desired_capacity":  "4",
+		"previous_capacity": "3",
+	}
+
+	expected := map[string]string{
+		"desired_capacity":  "4",
+		"previous_capacity": "3",
+	}
+
+	if got := changedValues(before, after); !reflect.DeepEqual(got, expected) {
+		t.Errorf("values does not match. expected: %#v, got: %#v", expected, got)
+	}
+
+	if got := changedValues(after, after); got != nil {
+		t.Errorf("nil should be returned if nothing is changed, got: %#v", got)
+	}
+}
+
+func TestRunSteps_events(t *testing.T) {
+	dir, err := ioutil.TempDir("", "esnctl")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	run, err := state.New(dir, "add", map[string]string{})
+	if err != nil {
+		t.Fatalf("failed to create run: %s", err)
+	}
+
+	steps := []step{
+		{
+			name:        "increase-instances",
+			description: "Launching 1 instances on elasticsearch",
+			run: func(ctx context.Context) error {
+				return run.Set("desired_capacity", "4")
+			},
+		},
+		{
+			name:        "wait-nodes-join",
+			description: "Waiting for nodes join to Elasticsearch cluster",
+			run: func(ctx context.Context) error {
+				return errors.New("timed out")
+			},
+		},
+	}
+
+	var runErr error
+
+	output := captureOutput(outputJSON, func() {
+		runErr = runSteps(context.Background(), run, steps, false)
+	})
+
+	if runErr == nil {
+		t.Errorf("error should be raised")
+	}
+
+	events := []stepEvent{}
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		var event stepEvent
+
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatalf("invalid event %q: %s", line, err)
+		}
+
+		events = append(events, event)
+	}
+
+	expected := []struct {
+		step    string
+		status  string
+		details map[string]string
+		err     string
+	}{
+		{"increase-instances", stepStatusStarted, nil, ""},
+		{"increase-instances", stepStatusCompleted, map[string]string{"desired_capacity": "4"}, ""},
+		{"wait-nodes-join", stepStatusStarted, nil, ""},
+		{"wait-nodes-join", stepStatusFailed, nil, "timed out"},
+	}
+
+	if len(events) != len(expected) {
+		t.Fatalf("number of events does not match. expected: %d, got: %d", len(expected), len(events))
+	}
+
+	for i, e := range expected {
+		got := events[i]
+
+		if got.RunID != run.ID || got.Step != e.step || got.Status != e.status || got.Error != e.err {
+			t.Errorf("event does not match. expected: %+v, got: %+v", e, got)
+		}
+
+		if !reflect.DeepEqual(got.Details, e.details) {
+			t.Errorf("details does not match. expected: %#v, got: %#v", e.details, got.Details)
+		}
+
+		if got.Time == "" || got.StartedAt == "" {
+			t.Errorf("timestamps should be set, got: %+v", got)
+		}
+	}
+}
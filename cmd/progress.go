@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// drainLogInterval is how often progress is logged if the output is not a terminal
+	drainLogInterval = 30 * time.Second
+
+	progressBarWidth = 30
+)
+
+// drainProgress represents shards remaining on the nodes being drained
+type drainProgress struct {
+	shards     int
+	relocating int
+	// bytes excludes the bytes already copied by ongoing relocations
+	bytes int64
+}
+
+// measureDrain summarizes shards remaining on the given nodes
+func measureDrain(shards []*types.Shard, recoveries []*types.Recovery, nodeNames []string) *drainProgress {
+	progress := &drainProgress{}
+
+	for _, shard := range shards {
+		if !contains(nodeNames, shard.NodeName) {
+			continue
+		}
+
+		progress.shards++
+		progress.bytes += shard.StoreBytes
+
+		if shard.State != "RELOCATING" {
+			continue
+		}
+
+		progress.relocating++
+
+		for _, recovery := range recoveries {
+			if recovery.Index == shard.Index && recovery.Shard == shard.Shard && recovery.Stage != "done" {
+				progress.bytes -= recovery.BytesRecovered
+				break
+			}
+		}
+	}
+
+	if progress.bytes < 0 {
+		progress.bytes = 0
+	}
+
+	return progress
+}
+
+// retrieveDrainProgress measures shards remaining on the given nodes
+func retrieveDrainProgress(ctx context.Context, client es.Client, nodeNames []string) (*drainProgress, error) {
+	shards, err := client.ListShards(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list shards")
+	}
+
+	recoveries, err := client.ListRecoveries(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list recoveries")
+	}
+
+	return measureDrain(shards, recoveries, nodeNames), nil
+}
+
+// estimateETA estimates how long it takes to drain the remaining bytes, from throughput observed since the start
+// false is returned if nothing has been drained yet
+func estimateETA(startBytes, bytes int64, elapsed time.Duration) (time.Duration, bool) {
+	drained := startBytes - bytes
+	if drained <= 0 || elapsed <= 0 {
+		return 0, false
+	}
+
+	eta := time.Duration(float64(bytes) / float64(drained) * float64(elapsed))
+
+	return eta / time.Second * time.Second, true
+}
+
+// drainReporter reports drain progress as a live progress bar on terminal, or periodic log lines otherwise
+// With --output json / yaml, neither of them is printed, and periodic progress events of the given step are printed instead
+type drainReporter struct {
+	out    io.Writer
+	logger *log.Logger
+	tty    bool
+	now    func() time.Time
+
+	// events is true if progress is printed as step events of stepName in runID
+	events   bool
+	runID    string
+	stepName string
+
+	started    bool
+	startTime  time.Time
+	startBytes int64
+	loggedAt   time.Time
+}
+
+func newDrainReporter(runID, stepName string) *drainReporter {
+	return &drainReporter{
+		out:      stdout,
+		logger:   log.New(os.Stderr, "", log.LstdFlags),
+		tty:      !structuredOutput() && isTerminal(stdout),
+		now:      time.Now,
+		events:   structuredOutput(),
+		runID:    runID,
+		stepName: stepName,
+	}
+}
+
+// report prints the given progress
+// Throughput is measured from the first reported progress
+func (r *drainReporter) report(progress *drainProgress) {
+	now := r.now()
+
+	if !r.started {
+		r.started = true
+		r.startTime = now
+		r.startBytes = progress.bytes
+	}
+
+	eta := "unknown"
+	if d, ok := estimateETA(r.startBytes, progress.bytes, now.Sub(r.startTime)); ok {
+		eta = d.String()
+	}
+
+	summary := fmt.Sprintf("%d shards (%d relocating), %s remaining, ETA %s",
+		progress.shards, progress.relocating, formatBytes(progress.bytes), eta)
+
+	if r.tty && !r.events {
+		// \x1b[K clears the rest of the line left by longer previous output
+		fmt.Fprintf(r.out, "\r%s %s\x1b[K", progressBar(r.startBytes, progress.bytes), summary)
+		return
+	}
+
+	if progress.shards > 0 && !r.loggedAt.IsZero() && now.Sub(r.loggedAt) < drainLogInterval {
+		return
+	}
+
+	r.loggedAt = now
+
+	if r.events {
+		printObject(stepEvent{
+			RunID:  r.runID,
+			Step:   r.stepName,
+			Status: stepStatusProgress,
+			Time:   now.Format(time.RFC3339),
+			Details: map[string]string{
+				"shards":          strconv.Itoa(progress.shards),
+				"relocating":      strconv.Itoa(progress.relocating),
+				"bytes_remaining": strconv.FormatInt(progress.bytes, 10),
+				"eta":             eta,
+			},
+		})
+
+		return
+	}
+
+	r.logger.Printf("===> %s\n", summary)
+}
+
+// finish ends the progress bar line
+func (r *drainReporter) finish() {
+	if r.tty && !r.events && r.started {
+		fmt.Fprint(r.out, "\n")
+	}
+}
+
+// progressBar renders how much of startBytes has been drained, e.g. "[=======>      ]  50%"
+func progressBar(startBytes, bytes int64) string {
+	ratio := 1.0
+	if startBytes > 0 {
+		ratio = float64(startBytes-bytes) / float64(startBytes)
+	}
+
+	if ratio < 0 {
+		ratio = 0
+	}
+
+	filled := int(ratio * progressBarWidth)
+
+	bar := strings.Repeat("=", filled)
+	if filled < progressBarWidth {
+		bar += ">" + strings.Repeat(" ", progressBarWidth-filled-1)
+	}
+
+	return fmt.Sprintf("[%s] %3d%%", bar, int(ratio*100))
+}
+
+// isTerminal returns whether the given writer is a terminal
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"bytes"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dtan4/esnctl/es/types"
+)
+
+func TestMeasureDrain(t *testing.T) {
+	shards := []*types.Shard{
+		&types.Shard{Index: "wiki1", Shard: 0, State: "STARTED", StoreBytes: 1000, NodeName: "node-a"},
+		&types.Shard{Index: "wiki1", Shard: 1, State: "RELOCATING", StoreBytes: 2000, NodeName: "node-a", RelocatingNodeName: "node-c"},
+		&types.Shard{Index: "wiki1", Shard: 1, State: "INITIALIZING", StoreBytes: 500, NodeName: "node-c"},
+		&types.Shard{Index: "wiki1", Shard: 2, State: "STARTED", StoreBytes: 4000, NodeName: "node-c"},
+		&types.Shard{Index: "wiki1", Shard: 2, State: "UNASSIGNED"},
+	}
+
+	recoveries := []*types.Recovery{
+		&types.Recovery{Index: "wiki1", Shard: 0, Stage: "done", Bytes: 1000, BytesRecovered: 1000},
+		&types.Recovery{Index: "wiki1", Shard: 1, Stage: "index", Bytes: 2000, BytesRecovered: 500},
+	}
+
+	expected := &drainProgress{
+		shards:     2,
+		relocating: 1,
+		bytes:      2500,
+	}
+
+	got := measureDrain(shards, recoveries, []string{"node-a", "node-b"})
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("progress does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestEstimateETA(t *testing.T) {
+	testcases := []struct {
+		startBytes int64
+		bytes      int64
+		elapsed    time.Duration
+		expected   time.Duration
+		ok         bool
+	}{
+		{
+			startBytes: 3000,
+			bytes:      2000,
+			elapsed:    time.Minute,
+			expected:   2 * time.Minute,
+			ok:         true,
+		},
+		{
+			startBytes: 3000,
+			bytes:      1000,
+			elapsed:    10 * time.Second,
+			expected:   5 * time.Second,
+			ok:         true,
+		},
+		{
+			startBytes: 3000,
+			bytes:      3000,
+			elapsed:    time.Minute,
+			ok:         false,
+		},
+		{
+			// Shards can grow while indexing
+			startBytes: 3000,
+			bytes:      3500,
+			elapsed:    time.Minute,
+			ok:         false,
+		},
+	}
+
+	for _, tc := range testcases {
+		got, ok := estimateETA(tc.startBytes, tc.bytes, tc.elapsed)
+
+		if ok != tc.ok {
+			t.Errorf("ok does not match. expected: %t, got: %t", tc.ok, ok)
+			continue
+		}
+
+		if got != tc.expected {
+			t.Errorf("ETA does not match. expected: %s, got: %s", tc.expected, got)
+		}
+	}
+}
+
+func TestProgressBar(t *testing.T) {
+	testcases := []struct {
+		startBytes int64
+		bytes      int64
+		expected   string
+	}{
+		{
+			startBytes: 1000,
+			bytes:      1000,
+			expected:   "[>                             ]   0%",
+		},
+		{
+			startBytes: 1000,
+			bytes:      500,
+			expected:   "[===============>              ]  50%",
+		},
+		{
+			startBytes: 1000,
+			bytes:      0,
+			expected:   "[==============================] 100%",
+		},
+		{
+			startBytes: 0,
+			bytes:      0,
+			expected:   "[==============================] 100%",
+		},
+	}
+
+	for _, tc := range testcases {
+		if got := progressBar(tc.startBytes, tc.bytes); got != tc.expected {
+			t.Errorf("progress bar does not match. expected: %q, got: %q", tc.expected, got)
+		}
+	}
+}
+
+func TestDrainReporter_terminal(t *testing.T) {
+	out := &bytes.Buffer{}
+	now := time.Date(2017, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	r := &drainReporter{
+		out: out,
+		tty: true,
+		now: func() time.Time { return now },
+	}
+
+	r.report(&drainProgress{shards: 4, relocating: 2, bytes: 4096})
+
+	now = now.Add(time.Minute)
+	r.report(&drainProgress{shards: 2, relocating: 1, bytes: 2048})
+
+	r.finish()
+
+	expected := "\r[>                             ]   0% 4 shards (2 relocating), 4.0kb remaining, ETA unknown\x1b[K" +
+		"\r[===============>              ]  50% 2 shards (1 relocating), 2.0kb remaining, ETA 1m0s\x1b[K\n"
+
+	if got := out.String(); got != expected {
+		t.Errorf("output does not match. expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestDrainReporter_log(t *testing.T) {
+	out := &bytes.Buffer{}
+	now := time.Date(2017, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	r := &drainReporter{
+		logger: log.New(out, "", 0),
+		now:    func() time.Time { return now },
+	}
+
+	r.report(&drainProgress{shards: 4, relocating: 2, bytes: 4096})
+
+	// Skipped until drainLogInterval passes
+	now = now.Add(10 * time.Second)
+	r.report(&drainProgress{shards: 3, relocating: 2, bytes: 3072})
+
+	now = now.Add(drainLogInterval)
+	r.report(&drainProgress{shards: 2, relocating: 1, bytes: 2048})
+
+	// Completion is always logged
+	now = now.Add(time.Second)
+	r.report(&drainProgress{})
+
+	r.finish()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+
+	expected := []string{
+		"===> 4 shards (2 relocating), 4.0kb remaining, ETA unknown",
+		"===> 2 shards (1 relocating), 2.0kb remaining, ETA 40s",
+		"===> 0 shards (0 relocating), 0b remaining, ETA 0s",
+	}
+
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("output does not match. expected: %q, got: %q", expected, lines)
+	}
+}
+
+func TestDrainReporter_structured(t *testing.T) {
+	now := time.Date(2017, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	got := captureOutput(outputJSON, func() {
+		r := newDrainReporter("remove-20170320120000", "wait-shards-escape")
+		// Progress bar must not be printed even on terminal
+		r.tty = true
+		r.now = func() time.Time { return now }
+
+		r.report(&drainProgress{shards: 4, relocating: 2, bytes: 4096})
+		r.report(&drainProgress{})
+
+		r.finish()
+	})
+
+	expected := `{"run_id":"remove-20170320120000","step":"wait-shards-escape","status":"progress","time":"2017-03-20T12:00:00Z","details":{"bytes_remaining":"4096","eta":"unknown","relocating":"2","shards":"4"}}` + "\n" +
+		`{"run_id":"remove-20170320120000","step":"wait-shards-escape","status":"progress","time":"2017-03-20T12:00:00Z","details":{"bytes_remaining":"0","eta":"unknown","relocating":"0","shards":"0"}}` + "\n"
+
+	if got != expected {
+		t.Errorf("output does not match. expected: %q, got: %q", expected, got)
+	}
+}
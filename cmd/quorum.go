@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/pkg/errors"
+)
+
+// masterQuorum represents whether the remaining master-eligible nodes can still elect master after removing nodes
+type masterQuorum struct {
+	// removing is the names of master-eligible nodes to be removed
+	removing []string
+	// remaining is the number of master-eligible nodes, or nodes in the voting configuration, left after removal
+	remaining int
+	// required is the number of them required to elect master
+	required int
+	// votingConfig is true if quorum is based on the voting configuration of Elasticsearch 7.x or later,
+	// and false if it is based on discovery.zen.minimum_master_nodes
+	votingConfig bool
+}
+
+// intact returns whether master can still be elected after removal
+func (q *masterQuorum) intact() bool {
+	return len(q.removing) == 0 || q.remaining >= q.required
+}
+
+// check returns error if master cannot be elected after removal
+func (q *masterQuorum) check() error {
+	if !q.intact() {
+		return errors.Errorf("removing master-eligible nodes %s breaks master quorum (%s)", joinValues(q.removing), q)
+	}
+
+	return nil
+}
+
+// String describes quorum after removal, e.g. "2 of 3 nodes in voting configuration remain, 2 required"
+func (q *masterQuorum) String() string {
+	if q.votingConfig {
+		return fmt.Sprintf("%d nodes in voting configuration remain, %d required", q.remaining, q.required)
+	}
+
+	return fmt.Sprintf("%d master-eligible nodes remain, %d required by discovery.zen.minimum_master_nodes", q.remaining, q.required)
+}
+
+// estimateMasterQuorum counts master-eligible nodes left after removing the given nodes
+// With voting configuration, master is elected by a majority of the nodes in it.
+// Otherwise at least discovery.zen.minimum_master_nodes (or one if not set) master-eligible nodes are required
+func estimateMasterQuorum(nodes []*types.Node, quorum *types.MasterQuorum, nodeNames []string) *masterQuorum {
+	q := &masterQuorum{
+		removing: []string{},
+	}
+
+	removingIDs := []string{}
+	masters := 0
+
+	for _, node := range nodes {
+		if !node.MasterEligible {
+			continue
+		}
+
+		masters++
+
+		if contains(nodeNames, node.Name) {
+			q.removing = append(q.removing, node.Name)
+			removingIDs = append(removingIDs, node.ID)
+		}
+	}
+
+	if len(quorum.VotingConfig) > 0 {
+		q.votingConfig = true
+		q.required = len(quorum.VotingConfig)/2 + 1
+
+		for _, id := range quorum.VotingConfig {
+			if !contains(removingIDs, id) {
+				q.remaining++
+			}
+		}
+
+		return q
+	}
+
+	q.remaining = masters - len(q.removing)
+	q.required = quorum.MinimumMasterNodes
+
+	if q.required < 1 {
+		q.required = 1
+	}
+
+	return q
+}
+
+// retrieveMasterQuorum estimates master quorum of the cluster after removing the given nodes
+func retrieveMasterQuorum(ctx context.Context, client es.Client, nodeNames []string) (*masterQuorum, error) {
+	nodes, err := client.DescribeNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe nodes")
+	}
+
+	quorum, err := client.MasterQuorum(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve master quorum")
+	}
+
+	return estimateMasterQuorum(nodes, quorum, nodeNames), nil
+}
+
+// checkMasterQuorum refuses to remove master-eligible nodes if the remaining ones cannot elect master
+// Unlike other checks, this cannot be skipped by --force, because losing quorum makes the whole cluster unavailable
+func checkMasterQuorum(ctx context.Context, client es.Client, nodeNames []string) error {
+	q, err := retrieveMasterQuorum(ctx, client, nodeNames)
+	if err != nil {
+		return err
+	}
+
+	return q.check()
+}
+
+// votingExclusionTargets returns the given nodes which must be excluded from voting configuration before removal,
+// that is master-eligible ones
+// Nothing is returned if master is elected without voting configuration, i.e. on Elasticsearch 6.x or older
+func votingExclusionTargets(nodes []*types.Node, quorum *types.MasterQuorum) []*types.Node {
+	targets := []*types.Node{}
+
+	if len(quorum.VotingConfig) == 0 {
+		return targets
+	}
+
+	for _, node := range nodes {
+		if node.MasterEligible {
+			targets = append(targets, node)
+		}
+	}
+
+	return targets
+}
+
+// retrieveVotingExclusionTargets returns the names and IDs of the given nodes which must be excluded from voting configuration
+func retrieveVotingExclusionTargets(ctx context.Context, client es.Client, nodeNames []string) ([]string, []string, error) {
+	nodes, err := client.DescribeNodes(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to describe nodes")
+	}
+
+	quorum, err := client.MasterQuorum(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to retrieve master quorum")
+	}
+
+	targets := []*types.Node{}
+
+	for _, node := range nodes {
+		if contains(nodeNames, node.Name) {
+			targets = append(targets, node)
+		}
+	}
+
+	names := []string{}
+	ids := []string{}
+
+	for _, node := range votingExclusionTargets(targets, quorum) {
+		names = append(names, node.Name)
+		ids = append(ids, node.ID)
+	}
+
+	return names, ids, nil
+}
+
+// foreignVotingExclusions returns voting configuration exclusions which were not added by the run excluding the given nodes
+func foreignVotingExclusions(quorum *types.MasterQuorum, excluded []string) []string {
+	foreign := []string{}
+
+	for _, nodeName := range quorum.VotingConfigExclusions {
+		if !contains(excluded, nodeName) {
+			foreign = append(foreign, nodeName)
+		}
+	}
+
+	return foreign
+}
+
+// clearVotingExclusions clears voting configuration exclusions added by the run excluding the given nodes
+// Clearing API removes all exclusions at once, so nothing is cleared if other operations also have added ones
+func clearVotingExclusions(ctx context.Context, client es.Client, excluded []string) error {
+	quorum, err := client.MasterQuorum(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve master quorum")
+	}
+
+	if foreign := foreignVotingExclusions(quorum, excluded); len(foreign) > 0 {
+		log.Printf("===> Warning: voting configuration exclusions are kept because %s were excluded by other operations. Clear them by DELETE /_cluster/voting_config_exclusions when they are no longer needed\n", joinValues(foreign))
+		return nil
+	}
+
+	if len(quorum.VotingConfigExclusions) == 0 {
+		return nil
+	}
+
+	if err := client.ClearVotingConfigExclusions(ctx); err != nil {
+		return errors.Wrap(err, "failed to clear voting configuration exclusions")
+	}
+
+	return nil
+}
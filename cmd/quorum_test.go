@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dtan4/esnctl/es/types"
+)
+
+var testMasterNodes = []*types.Node{
+	&types.Node{ID: "id-master-a", Name: "master-a", MasterEligible: true, Roles: []string{"master"}},
+	&types.Node{ID: "id-master-b", Name: "master-b", MasterEligible: true, Roles: []string{"master"}},
+	&types.Node{ID: "id-master-c", Name: "master-c", MasterEligible: true, Roles: []string{"master"}},
+	&types.Node{ID: "id-data-a", Name: "data-a", Roles: []string{"data"}},
+}
+
+func TestEstimateMasterQuorum(t *testing.T) {
+	testcases := []struct {
+		quorum    *types.MasterQuorum
+		nodeNames []string
+		expected  *masterQuorum
+		intact    bool
+	}{
+		{
+			quorum: &types.MasterQuorum{
+				VotingConfig: []string{"id-master-a", "id-master-b", "id-master-c"},
+			},
+			nodeNames: []string{"master-a"},
+			expected:  &masterQuorum{removing: []string{"master-a"}, remaining: 2, required: 2, votingConfig: true},
+			intact:    true,
+		},
+		{
+			quorum: &types.MasterQuorum{
+				VotingConfig: []string{"id-master-a", "id-master-b", "id-master-c"},
+			},
+			nodeNames: []string{"master-a", "master-b"},
+			expected:  &masterQuorum{removing: []string{"master-a", "master-b"}, remaining: 1, required: 2, votingConfig: true},
+			intact:    false,
+		},
+		{
+			quorum: &types.MasterQuorum{
+				MinimumMasterNodes: 2,
+			},
+			nodeNames: []string{"master-a", "data-a"},
+			expected:  &masterQuorum{removing: []string{"master-a"}, remaining: 2, required: 2},
+			intact:    true,
+		},
+		{
+			quorum: &types.MasterQuorum{
+				MinimumMasterNodes: 3,
+			},
+			nodeNames: []string{"master-c"},
+			expected:  &masterQuorum{removing: []string{"master-c"}, remaining: 2, required: 3},
+			intact:    false,
+		},
+		{
+			quorum:    &types.MasterQuorum{},
+			nodeNames: []string{"master-a", "master-b", "master-c"},
+			expected:  &masterQuorum{removing: []string{"master-a", "master-b", "master-c"}, remaining: 0, required: 1},
+			intact:    false,
+		},
+		{
+			quorum: &types.MasterQuorum{
+				MinimumMasterNodes: 3,
+			},
+			nodeNames: []string{"data-a"},
+			expected:  &masterQuorum{removing: []string{}, remaining: 3, required: 3},
+			intact:    true,
+		},
+	}
+
+	for _, tc := range testcases {
+		got := estimateMasterQuorum(testMasterNodes, tc.quorum, tc.nodeNames)
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("quorum does not match. expected: %#v, got: %#v", tc.expected, got)
+		}
+
+		if got.intact() != tc.intact {
+			t.Errorf("intact does not match. expected: %t, got: %t (%s)", tc.intact, got.intact(), got)
+		}
+
+		if err := got.check(); (err == nil) != tc.intact {
+			t.Errorf("error should be raised only if quorum is not intact, got: %v", err)
+		}
+	}
+}
+
+func TestVotingExclusionTargets(t *testing.T) {
+	targets := []*types.Node{testMasterNodes[0], testMasterNodes[3]}
+
+	testcases := []struct {
+		quorum   *types.MasterQuorum
+		expected []*types.Node
+	}{
+		{
+			quorum: &types.MasterQuorum{
+				VotingConfig: []string{"id-master-a", "id-master-b", "id-master-c"},
+			},
+			expected: []*types.Node{testMasterNodes[0]},
+		},
+		{
+			quorum: &types.MasterQuorum{
+				MinimumMasterNodes: 2,
+			},
+			expected: []*types.Node{},
+		},
+	}
+
+	for _, tc := range testcases {
+		if got := votingExclusionTargets(targets, tc.quorum); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("nodes does not match. expected: %#v, got: %#v", tc.expected, got)
+		}
+	}
+}
+
+func TestForeignVotingExclusions(t *testing.T) {
+	testcases := []struct {
+		exclusions []string
+		expected   []string
+	}{
+		{
+			exclusions: []string{},
+			expected:   []string{},
+		},
+		{
+			exclusions: []string{"node-a", "node-b"},
+			expected:   []string{},
+		},
+		{
+			exclusions: []string{"node-a", "node-c"},
+			expected:   []string{"node-c"},
+		},
+	}
+
+	for _, tc := range testcases {
+		quorum := &types.MasterQuorum{
+			VotingConfigExclusions: tc.exclusions,
+		}
+
+		if got := foreignVotingExclusions(quorum, []string{"node-a", "node-b"}); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("exclusions does not match. expected: %q, got: %q", tc.expected, got)
+		}
+	}
+}
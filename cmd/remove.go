@@ -1,20 +1,39 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dtan4/esnctl/aws"
 	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/state"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
 const (
-	removeMaxRetry     = 60
-	removeSleepSeconds = 5
+	removeValueAutoScalingGroup = "group"
+	removeValueClusterURL       = "cluster_url"
+	removeValueExcludeBy        = "exclude_by"
+	removeValueExcludeValues    = "exclude_values"
+	removeValueForce            = "force"
+	removeValueInstanceIDs      = "instance_ids"
+	removeValueLoadBalancer     = "load_balancer"
+	removeValueLoadBalancerKind = "load_balancer_kind"
+	removeValueLoadBalancers    = "load_balancers"
+	removeValueNodeNames        = "node_names"
+	removeValueRegion           = "region"
+	removeValueRole             = "role"
+	removeValueRollback         = "rollback_on_failure"
+	removeValueTargetBy         = "target_by"
+	removeValueTargetGroupARN   = "target_group_arn"
+	removeValueTargets          = "targets"
+	removeValueVotingExclusions = "voting_exclusions"
 )
 
 // removeCmd represents the remove command
@@ -22,16 +41,30 @@ var removeCmd = &cobra.Command{
 	SilenceErrors: true,
 	SilenceUsage:  true,
 	Use:           "remove",
-	Short:         "Remove node from Elasticsearch cluster",
+	Short:         "Remove nodes from Elasticsearch cluster",
 	RunE:          doRemove,
 }
 
 var removeOpts = struct {
+	auto             bool
 	autoScalingGroup string
 	clusterURL       string
-	nodeName         string
+	count            int
+	dryRun           bool
+	excludeBy        string
+	force            bool
+	instanceIDs      []string
+	nodeIDs          []string
+	nodeIPs          []string
+	nodeNames        []string
 	region           string
-}{}
+	role             string
+	rollback         bool
+	stateDir         string
+	wait             waitConfig
+}{
+	wait: defaultWaitConfig(),
+}
 
 func doRemove(cmd *cobra.Command, args []string) error {
 	if removeOpts.clusterURL == "" {
@@ -42,10 +75,118 @@ func doRemove(cmd *cobra.Command, args []string) error {
 		return errors.New("Auto Scaling Group (--group) must be specified")
 	}
 
-	if removeOpts.nodeName == "" {
-		return errors.New("Elasticsearch Node (--node-name) name must be specified")
+	if err := removeOpts.wait.validate(); err != nil {
+		return err
+	}
+
+	ctx, stopHandlingSignals := newSignalContext()
+	defer stopHandlingSignals()
+
+	var (
+		targetBy string
+		targets  []string
+		err      error
+	)
+
+	if removeOpts.auto {
+		targetBy, targets, err = autoTargets(ctx)
+	} else {
+		targetBy, targets, err = selectTargets(removeOpts.nodeNames, removeOpts.nodeIPs, removeOpts.nodeIDs, removeOpts.instanceIDs)
+	}
+	if err != nil {
+		return err
+	}
+
+	excludeBy := removeOpts.excludeBy
+	if excludeBy == "" {
+		excludeBy = defaultExcludeAttribute(targetBy)
+	}
+
+	if removeOpts.dryRun {
+		return planRemove(ctx, targetBy, targets, excludeBy)
+	}
+
+	values := map[string]string{
+		removeValueAutoScalingGroup: removeOpts.autoScalingGroup,
+		removeValueClusterURL:       removeOpts.clusterURL,
+		removeValueExcludeBy:        excludeBy,
+		removeValueForce:            strconv.FormatBool(removeOpts.force),
+		removeValueRegion:           removeOpts.region,
+		removeValueRole:             removeOpts.role,
+		removeValueRollback:         strconv.FormatBool(removeOpts.rollback),
+		removeValueTargetBy:         targetBy,
+		removeValueTargets:          joinValues(targets),
+	}
+	removeOpts.wait.setValues(values)
+
+	run, err := newRun(removeOpts.stateDir, "remove", values)
+	if err != nil {
+		return err
+	}
+
+	return runRemove(ctx, run)
+}
+
+// autoTargets chooses nodes to remove by balanced policy
+func autoTargets(ctx context.Context) (string, []string, error) {
+	if len(removeOpts.nodeNames) > 0 || len(removeOpts.nodeIPs) > 0 || len(removeOpts.nodeIDs) > 0 || len(removeOpts.instanceIDs) > 0 {
+		return "", nil, errors.New("--auto and target nodes cannot be specified at the same time")
+	}
+
+	if removeOpts.count < 1 {
+		return "", nil, errors.New("number of nodes to remove (--count) must be greater than 0")
+	}
+
+	if err := aws.Initialize(removeOpts.region); err != nil {
+		return "", nil, errors.Wrap(err, "failed to initialize AWS service clients")
+	}
+
+	client, err := es.New(removeOpts.clusterURL, &http.Client{})
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to create Elasitcsearch API client")
 	}
 
+	candidates, err := listCandidates(ctx, client, removeOpts.autoScalingGroup)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to list nodes which can be removed")
+	}
+
+	if removeOpts.role != "" {
+		candidates = filterCandidatesByRole(candidates, removeOpts.role)
+	}
+
+	victims, err := selectVictims(candidates, balancedPolicy, removeOpts.count)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to select nodes to remove")
+	}
+
+	instanceIDs := []string{}
+
+	for _, victim := range victims {
+		log.Printf("===> Selected %s (instance: %s, az: %s, shards: %d)\n", victim.node.Name, victim.instance.ID, victim.instance.AvailabilityZone, victim.shards)
+		instanceIDs = append(instanceIDs, victim.instance.ID)
+	}
+
+	return targetByInstanceID, instanceIDs, nil
+}
+
+func resumeRemove(ctx context.Context, run *state.Run) error {
+	wait, err := loadWaitConfig(run)
+	if err != nil {
+		return err
+	}
+
+	removeOpts.autoScalingGroup = run.Get(removeValueAutoScalingGroup)
+	removeOpts.clusterURL = run.Get(removeValueClusterURL)
+	removeOpts.force = run.Get(removeValueForce) == "true"
+	removeOpts.region = run.Get(removeValueRegion)
+	removeOpts.rollback = run.Get(removeValueRollback) == "true"
+	removeOpts.wait = wait
+
+	return runRemove(ctx, run)
+}
+
+func runRemove(ctx context.Context, run *state.Run) error {
 	httpClient := &http.Client{}
 
 	client, err := es.New(removeOpts.clusterURL, httpClient)
@@ -57,104 +198,619 @@ func doRemove(cmd *cobra.Command, args []string) error {
 		return errors.Wrap(err, "failed to initialize AWS service clients")
 	}
 
-	log.Println("===> Retrieving target instance ID...")
+	steps := []step{
+		checkClusterHealthStep(client, removeOpts.force),
+	}
+	steps = append(steps, removeSteps(run, client, "", removeOpts.autoScalingGroup, removeOpts.force, removeOpts.wait)...)
 
-	instanceID, err := aws.EC2.RetrieveInstanceIDFromPrivateDNS(removeOpts.nodeName)
-	if err != nil {
-		return errors.Wrap(err, "failed to retrieve instance ID")
+	return runSteps(ctx, run, steps, removeOpts.rollback)
+}
+
+// removeSteps returns the steps to remove the target nodes stored in the run from the given Auto Scaling Group
+// Step names and values of the run are prefixed with prefix, so that one run can contain multiple remove workflows
+// Removal is refused if the remaining nodes cannot absorb data on the target nodes, unless force is true
+func removeSteps(run *state.Run, client es.Client, prefix, group string, force bool, wait waitConfig) []step {
+	return []step{
+		{
+			name:        prefix + "resolve-targets",
+			description: "Resolving target nodes and instances",
+			run: func(ctx context.Context) error {
+				nodes, instanceIDs, err := resolveTargets(ctx, client, group, run.Get(prefix+removeValueTargetBy), splitValues(run.Get(prefix+removeValueTargets)))
+				if err != nil {
+					return errors.Wrap(err, "failed to resolve target nodes")
+				}
+
+				if err := checkRole(nodes, run.Get(prefix+removeValueRole)); err != nil {
+					return err
+				}
+
+				values, err := excludeValues(nodes, run.Get(prefix+removeValueExcludeBy))
+				if err != nil {
+					return errors.Wrap(err, "failed to resolve values to exclude nodes by")
+				}
+
+				nodeNames := []string{}
+
+				for _, node := range nodes {
+					nodeNames = append(nodeNames, node.Name)
+				}
+
+				if err := run.Set(prefix+removeValueNodeNames, joinValues(nodeNames)); err != nil {
+					return errors.Wrap(err, "failed to save node names")
+				}
+
+				if err := run.Set(prefix+removeValueExcludeValues, joinValues(values)); err != nil {
+					return errors.Wrap(err, "failed to save values to exclude nodes by")
+				}
+
+				return run.Set(prefix+removeValueInstanceIDs, joinValues(instanceIDs))
+			},
+		},
+		{
+			name:        prefix + "check-disk-capacity",
+			description: "Checking disk capacity of remaining nodes",
+			run: func(ctx context.Context) error {
+				return checkDiskCapacity(ctx, client, splitValues(run.Get(prefix+removeValueNodeNames)), force)
+			},
+		},
+		{
+			name:        prefix + "check-master-quorum",
+			description: "Checking master quorum of remaining nodes",
+			run: func(ctx context.Context) error {
+				return checkMasterQuorum(ctx, client, splitValues(run.Get(prefix+removeValueNodeNames)))
+			},
+		},
+		{
+			// Step names are kept from when only target groups were supported, so that older runs can be resumed
+			name:        prefix + "retrieve-target-group",
+			description: "Retrieving load balancers",
+			run: func(ctx context.Context) error {
+				lbs, err := aws.RetrieveLoadBalancers(ctx, group)
+				if err != nil {
+					return errors.Wrap(err, "failed to retrieve load balancers")
+				}
+
+				if len(lbs) == 0 {
+					return errors.Errorf("neither Classic Load Balancer nor target group is attached to %q", group)
+				}
+
+				values := []string{}
+
+				for _, lb := range lbs {
+					values = append(values, lb.Kind()+":"+lb.ID())
+				}
+
+				return run.Set(prefix+removeValueLoadBalancers, joinValues(values))
+			},
+		},
+		{
+			name:        prefix + "detach-from-target-group",
+			description: "Detaching instances from load balancers",
+			run: func(ctx context.Context) error {
+				lbs, err := savedLoadBalancers(run, prefix)
+				if err != nil {
+					return err
+				}
+
+				instanceIDs := splitValues(run.Get(prefix + removeValueInstanceIDs))
+
+				for _, lb := range lbs {
+					if err := lb.DetachInstances(ctx, instanceIDs); err != nil {
+						return errors.Wrapf(err, "failed to detach instances from %s", lb.ID())
+					}
+				}
+
+				return nil
+			},
+			rollback: func(ctx context.Context) error {
+				lbs, err := savedLoadBalancers(run, prefix)
+				if err != nil {
+					return err
+				}
+
+				instanceIDs := splitValues(run.Get(prefix + removeValueInstanceIDs))
+
+				for _, lb := range lbs {
+					if err := lb.RegisterInstances(ctx, instanceIDs); err != nil {
+						return errors.Wrapf(err, "failed to register instances to %s", lb.ID())
+					}
+				}
+
+				return nil
+			},
+		},
+		{
+			name:        prefix + "wait-connection-draining",
+			description: "Waiting for connection draining",
+			run: func(ctx context.Context) error {
+				lbs, err := savedLoadBalancers(run, prefix)
+				if err != nil {
+					return err
+				}
+
+				instanceIDs := splitValues(run.Get(prefix + removeValueInstanceIDs))
+
+				var drainingTimeout time.Duration
+
+				for _, lb := range lbs {
+					err = wait.waitUntil(ctx, wait.deregisterTimeout, func() (bool, error) {
+						instances, err := lb.ListInstances(ctx)
+						if err != nil {
+							return false, errors.Wrapf(err, "failed to list instances attached to %s", lb.ID())
+						}
+
+						return !containsAny(instances, instanceIDs), nil
+					})
+					if err == errWaitTimeout {
+						return errors.Errorf("timed out: instances still remain on %s after %s", lb.ID(), wait.deregisterTimeout)
+					}
+
+					if err != nil {
+						return err
+					}
+
+					timeout, err := lb.DrainingTimeout(ctx)
+					if err != nil {
+						return errors.Wrapf(err, "failed to retrieve connection draining timeout of %s", lb.ID())
+					}
+
+					if timeout > drainingTimeout {
+						drainingTimeout = timeout
+					}
+				}
+
+				if drainingTimeout > wait.deregisterTimeout {
+					drainingTimeout = wait.deregisterTimeout
+				}
+
+				return sleep(ctx, drainingTimeout)
+			},
+		},
+		{
+			name:        prefix + "exclude-from-allocation",
+			description: "Excluding target nodes from shard allocation group",
+			run: func(ctx context.Context) error {
+				if err := client.ExcludeNodesFromAllocation(ctx, run.Get(prefix+removeValueExcludeBy), splitValues(run.Get(prefix+removeValueExcludeValues))); err != nil {
+					return errors.Wrap(err, "failed to exclude nodes from allocation group")
+				}
+
+				return nil
+			},
+			rollback: func(ctx context.Context) error {
+				if err := client.IncludeNodesInAllocation(ctx, run.Get(prefix+removeValueExcludeBy), splitValues(run.Get(prefix+removeValueExcludeValues))); err != nil {
+					return errors.Wrap(err, "failed to include nodes in allocation group")
+				}
+
+				return nil
+			},
+		},
+		{
+			name:        prefix + "wait-shards-escape",
+			description: "Waiting for shards escape from target nodes",
+			run: func(ctx context.Context) error {
+				nodeNames := splitValues(run.Get(prefix + removeValueNodeNames))
+				reporter := newDrainReporter(run.ID, prefix+"wait-shards-escape")
+
+				err := wait.poll(ctx, wait.drainTimeout, func() (bool, error) {
+					progress, err := retrieveDrainProgress(ctx, client, nodeNames)
+					if err != nil {
+						return false, err
+					}
+
+					reporter.report(progress)
+
+					return progress.shards == 0, nil
+				})
+				reporter.finish()
+
+				if err == errWaitTimeout {
+					return errors.Errorf("timed out: shards do not escaped from the given nodes within %s", wait.drainTimeout)
+				}
+
+				return err
+			},
+		},
+		{
+			name:        prefix + "exclude-from-voting",
+			description: "Excluding master-eligible target nodes from voting configuration",
+			run: func(ctx context.Context) error {
+				nodeNames, _, err := retrieveVotingExclusionTargets(ctx, client, splitValues(run.Get(prefix+removeValueNodeNames)))
+				if err != nil {
+					return err
+				}
+
+				if len(nodeNames) == 0 {
+					return nil
+				}
+
+				// Excluded nodes are saved before being applied, so that rollback clears exclusions even if this step is interrupted
+				if err := run.Set(prefix+removeValueVotingExclusions, joinValues(nodeNames)); err != nil {
+					return errors.Wrap(err, "failed to save nodes excluded from voting configuration")
+				}
+
+				if err := client.AddVotingConfigExclusions(ctx, nodeNames); err != nil {
+					return errors.Wrap(err, "failed to exclude nodes from voting configuration")
+				}
+
+				return nil
+			},
+			rollback: func(ctx context.Context) error {
+				excluded := splitValues(run.Get(prefix + removeValueVotingExclusions))
+				if len(excluded) == 0 {
+					return nil
+				}
+
+				if err := clearVotingExclusions(ctx, client, excluded); err != nil {
+					return err
+				}
+
+				return run.Set(prefix+removeValueVotingExclusions, "")
+			},
+		},
+		{
+			name:        prefix + "wait-voting-exclusion",
+			description: "Waiting for target nodes to leave voting configuration",
+			run: func(ctx context.Context) error {
+				nodeNames := splitValues(run.Get(prefix + removeValueVotingExclusions))
+				if len(nodeNames) == 0 {
+					return nil
+				}
+
+				_, nodeIDs, err := retrieveVotingExclusionTargets(ctx, client, nodeNames)
+				if err != nil {
+					return err
+				}
+
+				err = wait.waitUntil(ctx, wait.drainTimeout, func() (bool, error) {
+					quorum, err := client.MasterQuorum(ctx)
+					if err != nil {
+						return false, errors.Wrap(err, "failed to retrieve master quorum")
+					}
+
+					return !containsAny(quorum.VotingConfig, nodeIDs), nil
+				})
+				if err == errWaitTimeout {
+					return errors.Errorf("timed out: nodes %s remain in voting configuration after %s", joinValues(nodeNames), wait.drainTimeout)
+				}
+
+				return err
+			},
+		},
+		{
+			name:        prefix + "shutdown-nodes",
+			description: "Shutting down target nodes",
+			run: func(ctx context.Context) error {
+				for _, nodeName := range splitValues(run.Get(prefix + removeValueNodeNames)) {
+					if err := client.Shutdown(ctx, nodeName); err != nil {
+						return errors.Wrapf(err, "failed to shutdown node %q", nodeName)
+					}
+				}
+
+				return nil
+			},
+			irreversible: true,
+		},
+		{
+			name:        prefix + "detach-from-auto-scaling-group",
+			description: "Detaching target instances",
+			run: func(ctx context.Context) error {
+				instances, err := aws.AutoScaling.ListInstances(ctx, group)
+				if err != nil {
+					return errors.Wrap(err, "failed to list instances in AutoScaling Group")
+				}
+
+				// Instances already detached by an interrupted run must be skipped
+				instanceIDs := []string{}
+
+				for _, instanceID := range splitValues(run.Get(prefix + removeValueInstanceIDs)) {
+					if contains(instances, instanceID) {
+						instanceIDs = append(instanceIDs, instanceID)
+					}
+				}
+
+				if len(instanceIDs) == 0 {
+					return nil
+				}
+
+				if err := aws.AutoScaling.DetachInstances(ctx, group, instanceIDs); err != nil {
+					return errors.Wrap(err, "failed to detach instances from AutoScaling Group")
+				}
+
+				return nil
+			},
+		},
+		{
+			name:        prefix + "include-in-allocation",
+			description: "Removing target nodes from shard allocation exclusions",
+			run: func(ctx context.Context) error {
+				// Entries of removed nodes must not be left, otherwise new instances reusing their IP addresses or names get no shards
+				if err := client.IncludeNodesInAllocation(ctx, run.Get(prefix+removeValueExcludeBy), splitValues(run.Get(prefix+removeValueExcludeValues))); err != nil {
+					return errors.Wrap(err, "failed to include nodes in allocation group")
+				}
+
+				return nil
+			},
+		},
+		{
+			name:        prefix + "clear-voting-exclusions",
+			description: "Clearing voting configuration exclusions",
+			run: func(ctx context.Context) error {
+				excluded := splitValues(run.Get(prefix + removeValueVotingExclusions))
+				if len(excluded) == 0 {
+					return nil
+				}
+
+				nodeNames, err := client.ListNodes(ctx)
+				if err != nil {
+					return errors.Wrap(err, "failed to list nodes")
+				}
+
+				// Clearing exclusions while excluded nodes are still in the cluster puts them back to voting configuration,
+				// and stopping them later may lose quorum
+				remaining := []string{}
+
+				for _, nodeName := range excluded {
+					if contains(nodeNames, nodeName) {
+						remaining = append(remaining, nodeName)
+					}
+				}
+
+				if len(remaining) > 0 {
+					log.Printf("===> Warning: voting configuration exclusions are kept because %s have not left the cluster yet. Clear them by DELETE /_cluster/voting_config_exclusions after stopping the nodes\n", joinValues(remaining))
+					return nil
+				}
+
+				return clearVotingExclusions(ctx, client, excluded)
+			},
+		},
 	}
+}
 
-	log.Println("===> Retrieving target group...")
+// savedLoadBalancers returns the load balancers saved in the given run as "kind:ID" values
+// Runs started before multiple load balancers were supported save only one load balancer,
+// and runs started before Classic Load Balancer was supported save only target group ARN
+func savedLoadBalancers(run *state.Run, prefix string) ([]aws.LoadBalancer, error) {
+	if kind := run.Get(prefix + removeValueLoadBalancerKind); kind != "" {
+		lb, err := aws.NewLoadBalancer(kind, run.Get(prefix+removeValueLoadBalancer))
+		if err != nil {
+			return []aws.LoadBalancer{}, err
+		}
 
-	targetGroupARN, err := aws.AutoScaling.RetrieveTargetGroup(removeOpts.autoScalingGroup)
-	if err != nil {
-		return errors.Wrap(err, "failed to retrieve target group")
+		return []aws.LoadBalancer{lb}, nil
 	}
 
-	log.Println("===> Detaching instance from target group...")
+	if arn := run.Get(prefix + removeValueTargetGroupARN); arn != "" {
+		lb, err := aws.NewLoadBalancer(aws.LoadBalancerKindTargetGroup, arn)
+		if err != nil {
+			return []aws.LoadBalancer{}, err
+		}
 
-	if err := aws.ELBv2.DetachInstance(targetGroupARN, instanceID); err != nil {
-		return errors.Wrap(err, "failed to detach instance from target group")
+		return []aws.LoadBalancer{lb}, nil
 	}
 
-	log.Println("===> Waiting for connection draining...")
+	lbs := []aws.LoadBalancer{}
 
-	retryCount := 0
+	for _, value := range splitValues(run.Get(prefix + removeValueLoadBalancers)) {
+		// Target group ARN contains ":", so only the first one separates kind
+		kv := strings.SplitN(value, ":", 2)
+		if len(kv) != 2 {
+			return []aws.LoadBalancer{}, errors.Errorf("invalid load balancer %q is saved", value)
+		}
 
-	for {
-		instances, err := aws.ELBv2.ListTargetInstances(targetGroupARN)
+		lb, err := aws.NewLoadBalancer(kv[0], kv[1])
 		if err != nil {
-			return errors.Wrap(err, "failed to list instances attached to target group")
+			return []aws.LoadBalancer{}, err
 		}
 
-		found := false
+		lbs = append(lbs, lb)
+	}
 
-		for _, instance := range instances {
-			if instance == instanceID {
-				found = true
-				break
-			}
+	return lbs, nil
+}
+
+// planRemove prints what runRemove will do, calling only read APIs
+func planRemove(ctx context.Context, targetBy string, targets []string, excludeBy string) error {
+	httpClient, transport := newDryRunHTTPClient()
+
+	client, err := es.New(removeOpts.clusterURL, httpClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	if err := aws.Initialize(removeOpts.region); err != nil {
+		return errors.Wrap(err, "failed to initialize AWS service clients")
+	}
+
+	if err := checkClusterHealth(ctx, client, removeOpts.force); err != nil {
+		return err
+	}
+
+	nodes, instanceIDs, err := resolveTargets(ctx, client, removeOpts.autoScalingGroup, targetBy, targets)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve target nodes")
+	}
+
+	if err := checkRole(nodes, removeOpts.role); err != nil {
+		return err
+	}
+
+	values, err := excludeValues(nodes, excludeBy)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve values to exclude nodes by")
+	}
+
+	nodeNames := []string{}
+	shardCounts := []int{}
+
+	for _, node := range nodes {
+		shards, err := client.ListShardsOnNode(ctx, node.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to list shards on %q", node.Name)
 		}
 
-		if !found {
-			fmt.Print("\n")
-			break
+		nodeNames = append(nodeNames, node.Name)
+		shardCounts = append(shardCounts, len(shards))
+	}
+
+	capacity, err := retrieveDiskCapacity(ctx, client, nodeNames)
+	if err != nil {
+		return err
+	}
+
+	quorum, err := retrieveMasterQuorum(ctx, client, nodeNames)
+	if err != nil {
+		return err
+	}
+
+	if err := quorum.check(); err != nil {
+		return err
+	}
+
+	progress, err := retrieveDrainProgress(ctx, client, nodeNames)
+	if err != nil {
+		return err
+	}
+
+	lbs, err := aws.RetrieveLoadBalancers(ctx, removeOpts.autoScalingGroup)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve load balancers")
+	}
+
+	if len(lbs) == 0 {
+		return errors.Errorf("neither Classic Load Balancer nor target group is attached to %q", removeOpts.autoScalingGroup)
+	}
+
+	deregisterRequests := []string{}
+
+	var drainingTimeout time.Duration
+
+	for _, lb := range lbs {
+		if lb.Kind() == aws.LoadBalancerKindClassic {
+			deregisterRequests = append(deregisterRequests, fmt.Sprintf("DeregisterInstancesFromLoadBalancer %s: %s", lb.ID(), joinValues(instanceIDs)))
+		} else {
+			deregisterRequests = append(deregisterRequests, fmt.Sprintf("DeregisterTargets %s: %s", lb.ID(), joinValues(instanceIDs)))
 		}
 
-		fmt.Print(".")
+		timeout, err := lb.DrainingTimeout(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to retrieve connection draining timeout of %s", lb.ID())
+		}
 
-		if retryCount == removeMaxRetry {
-			return errors.New("timed out: instance still remains on target group")
+		if timeout > drainingTimeout {
+			drainingTimeout = timeout
 		}
+	}
 
-		retryCount++
-		time.Sleep(removeSleepSeconds * time.Second)
+	currentDesiredCapacity, err := aws.AutoScaling.RetrieveDesiredCapacity(ctx, removeOpts.autoScalingGroup)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve desired capacity")
 	}
 
-	log.Println("===> Excluding target node from shard allocation group...")
+	desiredCapacity := currentDesiredCapacity - len(instanceIDs)
 
-	if err := client.ExcludeNodeFromAllocation(removeOpts.nodeName); err != nil {
-		return errors.Wrap(err, "failed to exclude node from allocation group")
+	if err := client.ExcludeNodesFromAllocation(ctx, excludeBy, values); err != nil {
+		return errors.Wrap(err, "failed to exclude nodes from allocation group")
 	}
 
-	log.Println("===> Waiting for shards escape from target node...")
+	excludeRequests := transport.take()
+
+	if err := client.IncludeNodesInAllocation(ctx, excludeBy, values); err != nil {
+		return errors.Wrap(err, "failed to include nodes in allocation group")
+	}
 
-	retryCount = 0
+	includeRequests := transport.take()
 
-	for {
-		shards, err := client.ListShardsOnNode(removeOpts.nodeName)
-		if err != nil {
-			return errors.Wrap(err, "failed to list shards on the given node")
+	votingQuorum, err := client.MasterQuorum(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve master quorum")
+	}
+
+	votingNodeNames := []string{}
+
+	for _, node := range votingExclusionTargets(nodes, votingQuorum) {
+		votingNodeNames = append(votingNodeNames, node.Name)
+	}
+
+	votingRequests := []string{"(no master-eligible target nodes in voting configuration)"}
+	clearVotingRequests := []string{"(nothing to do)"}
+
+	if len(votingNodeNames) > 0 {
+		if err := client.AddVotingConfigExclusions(ctx, votingNodeNames); err != nil {
+			return errors.Wrap(err, "failed to exclude nodes from voting configuration")
 		}
 
-		if len(shards) == 0 {
-			fmt.Print("\n")
-			break
+		votingRequests = transport.take()
+
+		if err := client.ClearVotingConfigExclusions(ctx); err != nil {
+			return errors.Wrap(err, "failed to clear voting configuration exclusions")
 		}
 
-		fmt.Print(".")
+		clearVotingRequests = append(transport.take(), "(only after target nodes leave the cluster, and if no other exclusions exist)")
+	}
 
-		if retryCount == removeMaxRetry {
-			return errors.New("timed out: shards do not escaped from the given node")
+	for _, node := range nodes {
+		if err := client.Shutdown(ctx, node.Name); err != nil {
+			return errors.Wrapf(err, "failed to shutdown node %q", node.Name)
 		}
+	}
 
-		retryCount++
-		time.Sleep(removeSleepSeconds * time.Second)
+	shutdownRequests := transport.take()
+	if len(shutdownRequests) == 0 {
+		shutdownRequests = []string{"(nothing to do for this Elasticsearch version)"}
 	}
 
-	log.Println("===> Shutting down target node...")
+	log.Println("===> Dry run: no changes will be made")
 
-	if err := client.Shutdown(removeOpts.nodeName); err != nil {
-		return errors.Wrap(err, "failed to shutdown node")
+	fmt.Println("Target nodes:")
+
+	for i, node := range nodes {
+		fmt.Printf("  - %s (ip: %s, instance: %s, shards: %d)\n", node.Name, node.IP, instanceIDs[i], shardCounts[i])
 	}
 
-	log.Println("===> Detaching target instance...")
+	fmt.Println("Load balancers:")
+
+	for _, lb := range lbs {
+		fmt.Printf("  - %s (%s)\n", lb.ID(), lb.Kind())
+	}
+
+	fmt.Printf("Auto Scaling Group: %s\n", removeOpts.autoScalingGroup)
+	fmt.Printf("DesiredCapacity: %d -> %d\n", currentDesiredCapacity, desiredCapacity)
+	fmt.Println("Steps:")
+
+	printPlanStep(1, "Checking cluster health", []string{})
+	printPlanStep(2, "Checking disk capacity of remaining nodes", []string{
+		fmt.Sprintf("Required: %s, available under low watermark %s: %s, under high watermark %s: %s",
+			formatBytes(capacity.required), capacity.watermarks.Low, formatBytes(capacity.availableLow), capacity.watermarks.High, formatBytes(capacity.availableHigh)),
+	})
+	printPlanStep(3, "Checking master quorum of remaining nodes", []string{
+		quorum.String(),
+	})
+	drainingDetails := []string{fmt.Sprintf("up to %s", removeOpts.wait.deregisterTimeout)}
+
+	if drainingTimeout > removeOpts.wait.deregisterTimeout {
+		drainingTimeout = removeOpts.wait.deregisterTimeout
+	}
 
-	if err := aws.AutoScaling.DetachInstance(removeOpts.autoScalingGroup, instanceID); err != nil {
-		return errors.Wrap(err, "failed to detach instance from AutoScaling Group")
+	if drainingTimeout > 0 {
+		drainingDetails = append(drainingDetails, fmt.Sprintf("then %s of connection draining timeout", drainingTimeout))
 	}
 
-	log.Println("===> Finished!")
+	printPlanStep(4, "Detaching instances from load balancers", deregisterRequests)
+	printPlanStep(5, "Waiting for connection draining", drainingDetails)
+	printPlanStep(6, "Excluding target nodes from shard allocation group", excludeRequests)
+	printPlanStep(7, "Waiting for shards escape from target nodes", []string{
+		fmt.Sprintf("%d shards (%s) to move, up to %s", progress.shards, formatBytes(progress.bytes), removeOpts.wait.drainTimeout),
+	})
+	printPlanStep(8, "Excluding master-eligible target nodes from voting configuration", votingRequests)
+	printPlanStep(9, "Waiting for target nodes to leave voting configuration", []string{
+		fmt.Sprintf("up to %s", removeOpts.wait.drainTimeout),
+	})
+	printPlanStep(10, "Shutting down target nodes", shutdownRequests)
+	printPlanStep(11, "Detaching target instances", []string{
+		fmt.Sprintf("DetachInstances %s: %s (DesiredCapacity: %d -> %d)", removeOpts.autoScalingGroup, joinValues(instanceIDs), currentDesiredCapacity, desiredCapacity),
+	})
+	printPlanStep(12, "Removing target nodes from shard allocation exclusions", includeRequests)
+	printPlanStep(13, "Clearing voting configuration exclusions", clearVotingRequests)
 
 	return nil
 }
@@ -162,8 +818,23 @@ func doRemove(cmd *cobra.Command, args []string) error {
 func init() {
 	RootCmd.AddCommand(removeCmd)
 
+	removeCmd.Flags().BoolVar(&removeOpts.auto, "auto", false, "Choose nodes to remove automatically, keeping Availability Zones balanced and never choosing the elected master")
+	removeCmd.Flags().BoolVar(&removeOpts.wait.backoff, "backoff", false, "Double poll interval after each poll, up to 1m")
 	removeCmd.Flags().StringVar(&removeOpts.autoScalingGroup, "group", "", "Auto Scaling Group")
 	removeCmd.Flags().StringVar(&removeOpts.clusterURL, "cluster-url", "", "Elasticsearch cluster URL")
-	removeCmd.Flags().StringVar(&removeOpts.nodeName, "node-name", "", "Elasticsearch node name to remove")
+	removeCmd.Flags().IntVar(&removeOpts.count, "count", 1, "Number of nodes to remove, used with --auto")
+	removeCmd.Flags().DurationVar(&removeOpts.wait.deregisterTimeout, "deregister-timeout", defaultDeregisterTimeout, "Time to wait for instances to be deregistered from load balancer")
+	removeCmd.Flags().DurationVar(&removeOpts.wait.drainTimeout, "drain-timeout", defaultDrainTimeout, "Time to wait for shards to escape from target nodes")
+	removeCmd.Flags().BoolVar(&removeOpts.dryRun, "dry-run", false, "Print what will be done without making any changes")
+	removeCmd.Flags().StringVar(&removeOpts.excludeBy, "exclude-by", "", "Allocation filter attribute to exclude nodes by, e.g. _name, _ip, _id or aws_instance_id (default: derived from target flag)")
+	removeCmd.Flags().BoolVar(&removeOpts.force, "force", false, "Start even if the cluster is not green, and remove nodes even if the remaining nodes lack disk capacity")
+	removeCmd.Flags().StringSliceVar(&removeOpts.instanceIDs, "instance-id", []string{}, "EC2 instance IDs to remove (can be specified multiple times)")
+	removeCmd.Flags().StringSliceVar(&removeOpts.nodeIDs, "node-id", []string{}, "Elasticsearch node IDs to remove (can be specified multiple times)")
+	removeCmd.Flags().StringSliceVar(&removeOpts.nodeIPs, "node-ip", []string{}, "Elasticsearch node IP addresses to remove (can be specified multiple times)")
+	removeCmd.Flags().StringSliceVar(&removeOpts.nodeNames, "node-name", []string{}, "Elasticsearch node names to remove (can be specified multiple times)")
+	removeCmd.Flags().DurationVar(&removeOpts.wait.pollInterval, "poll-interval", defaultPollInterval, "Interval to poll Elasticsearch and AWS while waiting")
 	removeCmd.Flags().StringVar(&removeOpts.region, "region", "", "AWS region")
+	removeCmd.Flags().StringVar(&removeOpts.role, "role", "", "Remove only nodes having the given role, e.g. data or master. With --auto, nodes are chosen among them")
+	removeCmd.Flags().BoolVar(&removeOpts.rollback, "rollback-on-failure", false, "Re-register instances to load balancer and include nodes in allocation group again if removal fails")
+	removeCmd.Flags().StringVar(&removeOpts.stateDir, "state-dir", "", "Directory to store progress of this run (default: ~/.esnctl/runs)")
 }
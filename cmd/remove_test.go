@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/state"
+)
+
+// allocationClient keeps cluster.routing.allocation.exclude.* in memory
+// Calling other methods of es.Client panics
+type allocationClient struct {
+	es.Client
+
+	excluded map[string][]string
+}
+
+func (c *allocationClient) ExcludeNodesFromAllocation(ctx context.Context, attribute string, values []string) error {
+	for _, value := range values {
+		if !contains(c.excluded[attribute], value) {
+			c.excluded[attribute] = append(c.excluded[attribute], value)
+		}
+	}
+
+	return nil
+}
+
+func (c *allocationClient) IncludeNodesInAllocation(ctx context.Context, attribute string, values []string) error {
+	remaining := []string{}
+
+	for _, value := range c.excluded[attribute] {
+		if !contains(values, value) {
+			remaining = append(remaining, value)
+		}
+	}
+
+	c.excluded[attribute] = remaining
+
+	return nil
+}
+
+func TestRemoveSteps_allocationExclusions(t *testing.T) {
+	client := &allocationClient{
+		excluded: map[string][]string{
+			"_ip": []string{"10.0.1.99"},
+		},
+	}
+
+	run := &state.Run{
+		Values: map[string]string{
+			removeValueExcludeBy:     "_ip",
+			removeValueExcludeValues: "10.0.1.21,10.0.1.22",
+		},
+	}
+
+	expected := map[string][]string{
+		"_ip": []string{"10.0.1.99"},
+	}
+
+	ctx := context.Background()
+
+	for _, s := range removeSteps(run, client, "", "elasticsearch", false, defaultWaitConfig()) {
+		switch s.name {
+		case "exclude-from-allocation":
+			if err := s.run(ctx); err != nil {
+				t.Fatalf("error should not be raised: %s", err)
+			}
+
+			if !contains(client.excluded["_ip"], "10.0.1.21") || !contains(client.excluded["_ip"], "10.0.1.22") {
+				t.Errorf("target nodes should be excluded, got: %q", client.excluded["_ip"])
+			}
+		case "include-in-allocation":
+			if err := s.run(ctx); err != nil {
+				t.Fatalf("error should not be raised: %s", err)
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(client.excluded, expected) {
+		t.Errorf("excluded values do not match. expected: %q, got: %q", expected, client.excluded)
+	}
+}
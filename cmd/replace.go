@@ -0,0 +1,301 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/dtan4/esnctl/aws"
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/state"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	replaceValueAutoScalingGroup = "group"
+	replaceValueBatches          = "batches"
+	replaceValueClusterURL       = "cluster_url"
+	replaceValueForce            = "force"
+	replaceValueRegion           = "region"
+)
+
+// replaceCmd represents the replace command
+var replaceCmd = &cobra.Command{
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	Use:           "replace",
+	Short:         "Replace nodes with fresh instances",
+	RunE:          doReplace,
+}
+
+var replaceOpts = struct {
+	all              bool
+	autoScalingGroup string
+	batchSize        int
+	clusterURL       string
+	dryRun           bool
+	excludeBy        string
+	force            bool
+	instanceIDs      []string
+	nodeIDs          []string
+	nodeIPs          []string
+	nodeNames        []string
+	region           string
+	stateDir         string
+	wait             waitConfig
+}{
+	wait: defaultWaitConfig(),
+}
+
+func doReplace(cmd *cobra.Command, args []string) error {
+	if replaceOpts.clusterURL == "" {
+		return errors.New("Elasticsearch cluster URL (--cluster-url) must be specified")
+	}
+
+	if replaceOpts.autoScalingGroup == "" {
+		return errors.New("Auto Scaling Group (--group) must be specified")
+	}
+
+	if replaceOpts.batchSize < 1 {
+		return errors.New("number of nodes replaced at a time (--batch-size) must be greater than 0")
+	}
+
+	if err := replaceOpts.wait.validate(); err != nil {
+		return err
+	}
+
+	ctx, stopHandlingSignals := newSignalContext()
+	defer stopHandlingSignals()
+
+	if err := aws.Initialize(replaceOpts.region); err != nil {
+		return errors.Wrap(err, "failed to initialize AWS service clients")
+	}
+
+	var (
+		targetBy string
+		targets  []string
+		err      error
+	)
+
+	if replaceOpts.all {
+		if len(replaceOpts.nodeNames) > 0 || len(replaceOpts.nodeIPs) > 0 || len(replaceOpts.nodeIDs) > 0 || len(replaceOpts.instanceIDs) > 0 {
+			return errors.New("--all and target nodes cannot be specified at the same time")
+		}
+
+		targetBy = targetByInstanceID
+
+		targets, err = aws.AutoScaling.ListInstances(ctx, replaceOpts.autoScalingGroup)
+		if err != nil {
+			return errors.Wrap(err, "failed to list instances in AutoScaling Group")
+		}
+
+		if len(targets) == 0 {
+			return errors.Errorf("no instance found in %s", replaceOpts.autoScalingGroup)
+		}
+	} else {
+		targetBy, targets, err = selectTargets(replaceOpts.nodeNames, replaceOpts.nodeIPs, replaceOpts.nodeIDs, replaceOpts.instanceIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	excludeBy := replaceOpts.excludeBy
+	if excludeBy == "" {
+		excludeBy = defaultExcludeAttribute(targetBy)
+	}
+
+	batches := splitBatches(targets, replaceOpts.batchSize)
+
+	if replaceOpts.dryRun {
+		return planReplace(ctx, targetBy, batches, excludeBy)
+	}
+
+	values := map[string]string{
+		replaceValueAutoScalingGroup: replaceOpts.autoScalingGroup,
+		replaceValueBatches:          strconv.Itoa(len(batches)),
+		replaceValueClusterURL:       replaceOpts.clusterURL,
+		replaceValueForce:            strconv.FormatBool(replaceOpts.force),
+		replaceValueRegion:           replaceOpts.region,
+	}
+	replaceOpts.wait.setValues(values)
+
+	for i, batch := range batches {
+		prefix := batchPrefix(i + 1)
+
+		values[prefix+removeValueExcludeBy] = excludeBy
+		values[prefix+removeValueTargetBy] = targetBy
+		values[prefix+removeValueTargets] = joinValues(batch)
+	}
+
+	run, err := newRun(replaceOpts.stateDir, "replace", values)
+	if err != nil {
+		return err
+	}
+
+	return runReplace(ctx, run)
+}
+
+func resumeReplace(ctx context.Context, run *state.Run) error {
+	wait, err := loadWaitConfig(run)
+	if err != nil {
+		return err
+	}
+
+	replaceOpts.autoScalingGroup = run.Get(replaceValueAutoScalingGroup)
+	replaceOpts.clusterURL = run.Get(replaceValueClusterURL)
+	replaceOpts.force = run.Get(replaceValueForce) == "true"
+	replaceOpts.region = run.Get(replaceValueRegion)
+	replaceOpts.wait = wait
+
+	return runReplace(ctx, run)
+}
+
+func runReplace(ctx context.Context, run *state.Run) error {
+	httpClient := &http.Client{}
+
+	client, err := es.New(replaceOpts.clusterURL, httpClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	if err := aws.Initialize(replaceOpts.region); err != nil {
+		return errors.Wrap(err, "failed to initialize AWS service clients")
+	}
+
+	batches, err := strconv.Atoi(run.Get(replaceValueBatches))
+	if err != nil {
+		return errors.Wrap(err, "invalid number of batches in state file")
+	}
+
+	restoreReallocation := func() {
+		for i := 1; i <= batches; i++ {
+			newReallocationRestorer(run, client, batchPrefix(i))()
+		}
+	}
+
+	steps := []step{
+		checkClusterHealthStep(client, replaceOpts.force),
+	}
+
+	for i := 1; i <= batches; i++ {
+		steps = append(steps, replaceSteps(run, client, i, batches)...)
+	}
+
+	// Nodes of earlier batches have already been removed, so the whole run cannot be rolled back
+	if err := runSteps(ctx, run, steps, false); err != nil {
+		restoreReallocation()
+		return err
+	}
+
+	return nil
+}
+
+// replaceSteps returns the steps to replace the nodes in the given batch
+// New instances are launched and join to the cluster before the old nodes are drained
+func replaceSteps(run *state.Run, client es.Client, batch, batches int) []step {
+	prefix := batchPrefix(batch)
+	delta := len(splitValues(run.Get(prefix + removeValueTargets)))
+
+	steps := addSteps(run, client, prefix, replaceOpts.autoScalingGroup, delta, "", replaceOpts.wait)
+	steps = append(steps, removeSteps(run, client, prefix, replaceOpts.autoScalingGroup, replaceOpts.force, replaceOpts.wait)...)
+
+	for i := range steps {
+		steps[i].description = fmt.Sprintf("[%d/%d] %s", batch, batches, steps[i].description)
+	}
+
+	return steps
+}
+
+// planReplace prints what runReplace will do, calling only read APIs
+func planReplace(ctx context.Context, targetBy string, batches [][]string, excludeBy string) error {
+	httpClient, _ := newDryRunHTTPClient()
+
+	client, err := es.New(replaceOpts.clusterURL, httpClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	if err := checkClusterHealth(ctx, client, replaceOpts.force); err != nil {
+		return err
+	}
+
+	log.Println("===> Dry run: no changes will be made")
+
+	fmt.Printf("Auto Scaling Group: %s\n", replaceOpts.autoScalingGroup)
+	fmt.Printf("Batches: %d\n", len(batches))
+
+	for i, batch := range batches {
+		nodes, instanceIDs, err := resolveTargets(ctx, client, replaceOpts.autoScalingGroup, targetBy, batch)
+		if err != nil {
+			return errors.Wrap(err, "failed to resolve target nodes")
+		}
+
+		if _, err := excludeValues(nodes, excludeBy); err != nil {
+			return errors.Wrap(err, "failed to resolve values to exclude nodes by")
+		}
+
+		fmt.Printf("Batch %d/%d:\n", i+1, len(batches))
+
+		for j, node := range nodes {
+			fmt.Printf("  - %s (ip: %s, instance: %s)\n", node.Name, node.IP, instanceIDs[j])
+		}
+	}
+
+	fmt.Println("Steps of each batch:")
+
+	steps := addSteps(nil, client, "", replaceOpts.autoScalingGroup, replaceOpts.batchSize, "", replaceOpts.wait)
+	steps = append(steps, removeSteps(nil, client, "", replaceOpts.autoScalingGroup, replaceOpts.force, replaceOpts.wait)...)
+
+	for i, s := range steps {
+		printPlanStep(i+1, s.description, []string{})
+	}
+
+	return nil
+}
+
+// splitBatches splits the given values into batches which have size values at most
+func splitBatches(values []string, size int) [][]string {
+	batches := [][]string{}
+
+	for i := 0; i < len(values); i += size {
+		end := i + size
+		if end > len(values) {
+			end = len(values)
+		}
+
+		batches = append(batches, values[i:end])
+	}
+
+	return batches
+}
+
+func batchPrefix(batch int) string {
+	return fmt.Sprintf("batch-%d-", batch)
+}
+
+func init() {
+	RootCmd.AddCommand(replaceCmd)
+
+	replaceCmd.Flags().BoolVar(&replaceOpts.all, "all", false, "Replace all instances in the Auto Scaling Group")
+	replaceCmd.Flags().BoolVar(&replaceOpts.wait.backoff, "backoff", false, "Double poll interval after each poll, up to 1m")
+	replaceCmd.Flags().IntVar(&replaceOpts.batchSize, "batch-size", 1, "Number of nodes replaced at a time")
+	replaceCmd.Flags().StringVar(&replaceOpts.autoScalingGroup, "group", "", "Auto Scaling Group")
+	replaceCmd.Flags().StringVar(&replaceOpts.clusterURL, "cluster-url", "", "Elasticsearch cluster URL")
+	replaceCmd.Flags().DurationVar(&replaceOpts.wait.deregisterTimeout, "deregister-timeout", defaultDeregisterTimeout, "Time to wait for old instances to be deregistered from load balancer")
+	replaceCmd.Flags().DurationVar(&replaceOpts.wait.drainTimeout, "drain-timeout", defaultDrainTimeout, "Time to wait for shards to escape from old nodes")
+	replaceCmd.Flags().BoolVar(&replaceOpts.dryRun, "dry-run", false, "Print what will be done without making any changes")
+	replaceCmd.Flags().StringVar(&replaceOpts.excludeBy, "exclude-by", "", "Allocation filter attribute to exclude nodes by, e.g. _name, _ip, _id or aws_instance_id (default: derived from target flag)")
+	replaceCmd.Flags().BoolVar(&replaceOpts.force, "force", false, "Start even if the cluster is not green, and remove nodes even if the remaining nodes lack disk capacity")
+	replaceCmd.Flags().DurationVar(&replaceOpts.wait.healthTimeout, "health-timeout", defaultHealthTimeout, "Time to wait for the cluster to be green after new nodes join")
+	replaceCmd.Flags().StringSliceVar(&replaceOpts.instanceIDs, "instance-id", []string{}, "EC2 instance IDs to replace (can be specified multiple times)")
+	replaceCmd.Flags().DurationVar(&replaceOpts.wait.joinTimeout, "join-timeout", defaultJoinTimeout, "Time to wait for new nodes to join the cluster")
+	replaceCmd.Flags().StringSliceVar(&replaceOpts.nodeIDs, "node-id", []string{}, "Elasticsearch node IDs to replace (can be specified multiple times)")
+	replaceCmd.Flags().StringSliceVar(&replaceOpts.nodeIPs, "node-ip", []string{}, "Elasticsearch node IP addresses to replace (can be specified multiple times)")
+	replaceCmd.Flags().StringSliceVar(&replaceOpts.nodeNames, "node-name", []string{}, "Elasticsearch node names to replace (can be specified multiple times)")
+	replaceCmd.Flags().DurationVar(&replaceOpts.wait.pollInterval, "poll-interval", defaultPollInterval, "Interval to poll Elasticsearch and AWS while waiting")
+	replaceCmd.Flags().StringVar(&replaceOpts.region, "region", "", "AWS region")
+	replaceCmd.Flags().StringVar(&replaceOpts.stateDir, "state-dir", "", "Directory to store progress of this run (default: ~/.esnctl/runs)")
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBatches(t *testing.T) {
+	testcases := []struct {
+		values   []string
+		size     int
+		expected [][]string
+	}{
+		{
+			values:   []string{"i-1", "i-2", "i-3"},
+			size:     1,
+			expected: [][]string{{"i-1"}, {"i-2"}, {"i-3"}},
+		},
+		{
+			values:   []string{"i-1", "i-2", "i-3"},
+			size:     2,
+			expected: [][]string{{"i-1", "i-2"}, {"i-3"}},
+		},
+		{
+			values:   []string{"i-1", "i-2"},
+			size:     5,
+			expected: [][]string{{"i-1", "i-2"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		if got := splitBatches(tc.values, tc.size); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("batches does not match. expected: %q, got: %q", tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,455 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+
+	"github.com/dtan4/esnctl/aws"
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/dtan4/esnctl/state"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	restartValueClusterURL = "cluster_url"
+	restartValueCommand    = "restart_command"
+	restartValueForce      = "force"
+	restartValueNodeNames  = "node_names"
+	restartValueRegion     = "region"
+	restartValueStartTime  = "start_time"
+)
+
+// restartCmd represents the restart command
+var restartCmd = &cobra.Command{
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	Use:           "restart",
+	Short:         "Restart nodes one by one",
+	RunE:          doRestart,
+}
+
+var restartOpts = struct {
+	clusterURL     string
+	dryRun         bool
+	force          bool
+	nodeNames      []string
+	region         string
+	restartCommand string
+	stateDir       string
+	wait           waitConfig
+}{
+	wait: defaultWaitConfig(),
+}
+
+// restarter represents the way to restart Elasticsearch node
+type restarter interface {
+	Restart(ctx context.Context, node *types.Node) error
+}
+
+// rebootRestarter restarts node by rebooting EC2 instance running it
+type rebootRestarter struct{}
+
+// Restart reboots EC2 instance which has the IP address of the given node
+func (r *rebootRestarter) Restart(ctx context.Context, node *types.Node) error {
+	instanceID, err := aws.EC2.RetrieveInstanceIDFromPrivateIP(ctx, node.IP)
+	if err != nil {
+		return errors.Wrapf(err, "failed to retrieve instance ID of %q", node.Name)
+	}
+
+	if err := aws.EC2.RebootInstances(ctx, []string{instanceID}); err != nil {
+		return errors.Wrapf(err, "failed to reboot %s", instanceID)
+	}
+
+	return nil
+}
+
+// commandRestarter restarts node by running user-provided command with sh -c
+// Name, IP address and ID of the node are given via ESNCTL_NODE_NAME, ESNCTL_NODE_IP and ESNCTL_NODE_ID
+type commandRestarter struct {
+	command string
+}
+
+// Restart runs the command for the given node
+func (r *commandRestarter) Restart(ctx context.Context, node *types.Node) error {
+	cmd := exec.CommandContext(ctx, "sh", "-c", r.command)
+	cmd.Env = append(os.Environ(),
+		"ESNCTL_NODE_NAME="+node.Name,
+		"ESNCTL_NODE_IP="+node.IP,
+		"ESNCTL_NODE_ID="+node.ID,
+	)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	// Output of the command must not be mixed into the stream of step events
+	if structuredOutput() {
+		cmd.Stdout = os.Stderr
+	}
+
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to run restart command for %q", node.Name)
+	}
+
+	return nil
+}
+
+func newRestarter(command string) restarter {
+	if command == "" {
+		return &rebootRestarter{}
+	}
+
+	return &commandRestarter{
+		command: command,
+	}
+}
+
+func doRestart(cmd *cobra.Command, args []string) error {
+	if restartOpts.clusterURL == "" {
+		return errors.New("Elasticsearch cluster URL (--cluster-url) must be specified")
+	}
+
+	if err := restartOpts.wait.validate(); err != nil {
+		return err
+	}
+
+	ctx, stopHandlingSignals := newSignalContext()
+	defer stopHandlingSignals()
+
+	if restartOpts.dryRun {
+		return planRestart(ctx)
+	}
+
+	client, err := es.New(restartOpts.clusterURL, &http.Client{})
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	nodeNames, err := restartTargets(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	values := map[string]string{
+		restartValueClusterURL: restartOpts.clusterURL,
+		restartValueCommand:    restartOpts.restartCommand,
+		restartValueForce:      strconv.FormatBool(restartOpts.force),
+		restartValueNodeNames:  joinValues(nodeNames),
+		restartValueRegion:     restartOpts.region,
+	}
+	restartOpts.wait.setValues(values)
+
+	run, err := newRun(restartOpts.stateDir, "restart", values)
+	if err != nil {
+		return err
+	}
+
+	return runRestart(ctx, run)
+}
+
+// restartTargets returns the names of nodes to restart, with the elected master last
+// All nodes in the cluster are restarted unless --node-name is specified
+func restartTargets(ctx context.Context, client es.Client) ([]string, error) {
+	nodes, err := client.DescribeNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe nodes")
+	}
+
+	nodeNames := restartOpts.nodeNames
+
+	if len(nodeNames) == 0 {
+		nodeNames = []string{}
+
+		for _, node := range nodes {
+			nodeNames = append(nodeNames, node.Name)
+		}
+	} else if _, err := selectNodes(nodes, targetByNodeName, nodeNames); err != nil {
+		return nil, err
+	}
+
+	masterNodeID, err := client.MasterNodeID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve master node")
+	}
+
+	return electedMasterLast(nodes, nodeNames, masterNodeID), nil
+}
+
+// electedMasterLast returns the node names with the elected master moved to the end,
+// so that restarting them one by one forces master election only once
+func electedMasterLast(nodes []*types.Node, nodeNames []string, masterNodeID string) []string {
+	masterName := ""
+
+	for _, node := range nodes {
+		if node.ID == masterNodeID {
+			masterName = node.Name
+			break
+		}
+	}
+
+	ordered := []string{}
+
+	for _, nodeName := range nodeNames {
+		if nodeName != masterName {
+			ordered = append(ordered, nodeName)
+		}
+	}
+
+	if len(ordered) < len(nodeNames) {
+		ordered = append(ordered, masterName)
+	}
+
+	return ordered
+}
+
+func resumeRestart(ctx context.Context, run *state.Run) error {
+	wait, err := loadWaitConfig(run)
+	if err != nil {
+		return err
+	}
+
+	restartOpts.clusterURL = run.Get(restartValueClusterURL)
+	restartOpts.force = run.Get(restartValueForce) == "true"
+	restartOpts.region = run.Get(restartValueRegion)
+	restartOpts.restartCommand = run.Get(restartValueCommand)
+	restartOpts.wait = wait
+
+	return runRestart(ctx, run)
+}
+
+func runRestart(ctx context.Context, run *state.Run) error {
+	httpClient := &http.Client{}
+
+	client, err := es.New(restartOpts.clusterURL, httpClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	if err := aws.Initialize(restartOpts.region); err != nil {
+		return errors.Wrap(err, "failed to initialize AWS service clients")
+	}
+
+	nodeNames := splitValues(run.Get(restartValueNodeNames))
+	r := newRestarter(restartOpts.restartCommand)
+
+	restoreReallocation := func() {
+		for i := range nodeNames {
+			newReallocationRestorer(run, client, nodePrefix(i+1))()
+		}
+	}
+
+	steps := []step{
+		checkClusterHealthStep(client, restartOpts.force),
+	}
+
+	for i, nodeName := range nodeNames {
+		nodeSteps := restartSteps(run, client, r, nodePrefix(i+1), nodeName, restartOpts.wait)
+
+		for j := range nodeSteps {
+			nodeSteps[j].description = fmt.Sprintf("[%d/%d] %s", i+1, len(nodeNames), nodeSteps[j].description)
+		}
+
+		steps = append(steps, nodeSteps...)
+	}
+
+	// Restarted nodes cannot be un-restarted, so nothing is rolled back
+	if err := runSteps(ctx, run, steps, false); err != nil {
+		restoreReallocation()
+		return err
+	}
+
+	return nil
+}
+
+// restartSteps returns the steps to restart the given node
+// Step names and values of the run are prefixed with prefix, so that one run can restart multiple nodes
+func restartSteps(run *state.Run, client es.Client, r restarter, prefix, nodeName string, wait waitConfig) []step {
+	return []step{
+		disableReallocationStep(client, prefix),
+		{
+			name:        prefix + "flush",
+			description: "Flushing indices",
+			run: func(ctx context.Context) error {
+				if err := client.Flush(ctx); err != nil {
+					return errors.Wrap(err, "failed to flush indices")
+				}
+
+				return nil
+			},
+		},
+		{
+			name:        prefix + "restart-node",
+			description: fmt.Sprintf("Restarting %s", nodeName),
+			run: func(ctx context.Context) error {
+				// Start time is saved as a checkpoint before restarting. Once it is saved, the node may have been
+				// restarted by the interrupted run, so resuming this step never restarts the node again.
+				if run.Get(prefix+restartValueStartTime) != "" {
+					return nil
+				}
+
+				node, err := describeNode(ctx, client, nodeName)
+				if err != nil {
+					return err
+				}
+
+				if err := run.Set(prefix+restartValueStartTime, strconv.FormatInt(node.StartTime, 10)); err != nil {
+					return errors.Wrap(err, "failed to save start time of node")
+				}
+
+				if err := r.Restart(ctx, node); err != nil {
+					// The node has not been restarted, so resuming this step may restart it
+					if err := run.Set(prefix+restartValueStartTime, ""); err != nil {
+						log.Printf("===> Warning: failed to clear start time of %s: %s\n", nodeName, err)
+					}
+
+					return err
+				}
+
+				return nil
+			},
+			irreversible: true,
+		},
+		{
+			name:        prefix + "wait-node-rejoin",
+			description: fmt.Sprintf("Waiting for %s to rejoin to Elasticsearch cluster", nodeName),
+			run: func(ctx context.Context) error {
+				startTime, err := strconv.ParseInt(run.Get(prefix+restartValueStartTime), 10, 64)
+				if err != nil {
+					return errors.Wrap(err, "invalid start time of node in state file")
+				}
+
+				err = wait.waitUntil(ctx, wait.joinTimeout, func() (bool, error) {
+					nodes, err := client.DescribeNodes(ctx)
+					if err != nil {
+						return false, errors.Wrap(err, "failed to describe nodes")
+					}
+
+					return restarted(nodes, nodeName, startTime), nil
+				})
+				if err == errWaitTimeout {
+					return errors.Errorf("timed out: %s does not rejoin to Elasticsearch cluster within %s", nodeName, wait.joinTimeout)
+				}
+
+				return err
+			},
+		},
+		enableReallocationStep(client, prefix),
+		waitClusterGreenStep(client, prefix, wait),
+	}
+}
+
+// restarted returns whether the given node has joined with JVM started after the given time
+func restarted(nodes []*types.Node, nodeName string, startTime int64) bool {
+	for _, node := range nodes {
+		if node.Name == nodeName && node.StartTime != startTime {
+			return true
+		}
+	}
+
+	return false
+}
+
+func describeNode(ctx context.Context, client es.Client, nodeName string) (*types.Node, error) {
+	nodes, err := client.DescribeNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe nodes")
+	}
+
+	selected, err := selectNodes(nodes, targetByNodeName, []string{nodeName})
+	if err != nil {
+		return nil, err
+	}
+
+	return selected[0], nil
+}
+
+// planRestart prints what runRestart will do, calling only read APIs
+func planRestart(ctx context.Context) error {
+	httpClient, transport := newDryRunHTTPClient()
+
+	client, err := es.New(restartOpts.clusterURL, httpClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	if err := checkClusterHealth(ctx, client, restartOpts.force); err != nil {
+		return err
+	}
+
+	nodeNames, err := restartTargets(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if err := client.DisableReallocation(ctx); err != nil {
+		return errors.Wrap(err, "failed to disable reallocation")
+	}
+
+	disableRequests := transport.take()
+
+	if err := client.Flush(ctx); err != nil {
+		return errors.Wrap(err, "failed to flush indices")
+	}
+
+	flushRequests := transport.take()
+
+	if err := client.EnableReallocation(ctx); err != nil {
+		return errors.Wrap(err, "failed to enable reallocation")
+	}
+
+	enableRequests := transport.take()
+
+	method := "reboot EC2 instance"
+	if restartOpts.restartCommand != "" {
+		method = fmt.Sprintf("sh -c %q", restartOpts.restartCommand)
+	}
+
+	log.Println("===> Dry run: no changes will be made")
+
+	fmt.Println("Target nodes:")
+
+	for _, nodeName := range nodeNames {
+		fmt.Printf("  - %s\n", nodeName)
+	}
+
+	fmt.Printf("Restart method: %s\n", method)
+	fmt.Println("Steps of each node:")
+
+	printPlanStep(1, "Disabling shard reallocation", disableRequests)
+	printPlanStep(2, "Flushing indices", flushRequests)
+	printPlanStep(3, "Restarting node", []string{method})
+	printPlanStep(4, "Waiting for node to rejoin to Elasticsearch cluster", []string{
+		fmt.Sprintf("up to %s", restartOpts.wait.joinTimeout),
+	})
+	printPlanStep(5, "Enabling shard reallocation", enableRequests)
+	printPlanStep(6, "Waiting for cluster health to be green", []string{
+		fmt.Sprintf("up to %s", restartOpts.wait.healthTimeout),
+	})
+
+	return nil
+}
+
+func nodePrefix(n int) string {
+	return fmt.Sprintf("node-%d-", n)
+}
+
+func init() {
+	RootCmd.AddCommand(restartCmd)
+
+	restartCmd.Flags().BoolVar(&restartOpts.wait.backoff, "backoff", false, "Double poll interval after each poll, up to 1m")
+	restartCmd.Flags().StringVar(&restartOpts.clusterURL, "cluster-url", "", "Elasticsearch cluster URL")
+	restartCmd.Flags().BoolVar(&restartOpts.dryRun, "dry-run", false, "Print what will be done without making any changes")
+	restartCmd.Flags().BoolVar(&restartOpts.force, "force", false, "Start even if the cluster is not green")
+	restartCmd.Flags().DurationVar(&restartOpts.wait.healthTimeout, "health-timeout", defaultHealthTimeout, "Time to wait for the cluster to be green after each node rejoins")
+	restartCmd.Flags().DurationVar(&restartOpts.wait.joinTimeout, "join-timeout", defaultJoinTimeout, "Time to wait for each restarted node to rejoin the cluster")
+	restartCmd.Flags().StringSliceVar(&restartOpts.nodeNames, "node-name", []string{}, "Elasticsearch node names to restart (default: all nodes)")
+	restartCmd.Flags().DurationVar(&restartOpts.wait.pollInterval, "poll-interval", defaultPollInterval, "Interval to poll Elasticsearch and AWS while waiting")
+	restartCmd.Flags().StringVar(&restartOpts.region, "region", "", "AWS region")
+	restartCmd.Flags().StringVar(&restartOpts.restartCommand, "restart-command", "", "Command to restart node, run with sh -c (default: reboot EC2 instance)")
+	restartCmd.Flags().StringVar(&restartOpts.stateDir, "state-dir", "", "Directory to store progress of this run (default: ~/.esnctl/runs)")
+}
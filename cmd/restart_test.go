@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/dtan4/esnctl/es/types"
+)
+
+func TestCommandRestarter(t *testing.T) {
+	node := &types.Node{
+		ID:   "Ab1cD2eFG3hIJ4kLMnOpQr",
+		Name: "node-a",
+		IP:   "10.0.1.23",
+	}
+
+	ctx := context.Background()
+
+	r := newRestarter(`test "$ESNCTL_NODE_NAME" = node-a && test "$ESNCTL_NODE_IP" = 10.0.1.23 && test "$ESNCTL_NODE_ID" = Ab1cD2eFG3hIJ4kLMnOpQr`)
+
+	if err := r.Restart(ctx, node); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	r = newRestarter("exit 1")
+
+	if err := r.Restart(ctx, node); err == nil {
+		t.Errorf("error should be raised")
+	}
+}
+
+func TestRestarted(t *testing.T) {
+	nodes := []*types.Node{
+		&types.Node{
+			Name:      "node-a",
+			StartTime: 1490000000000,
+		},
+		&types.Node{
+			Name:      "node-b",
+			StartTime: 1490000100000,
+		},
+	}
+
+	if restarted(nodes, "node-a", 1490000000000) {
+		t.Errorf("node-a should not be regarded as restarted")
+	}
+
+	if !restarted(nodes, "node-b", 1490000000000) {
+		t.Errorf("node-b should be regarded as restarted")
+	}
+
+	if restarted(nodes, "node-c", 1490000000000) {
+		t.Errorf("node-c should not be regarded as restarted")
+	}
+}
+
+func TestElectedMasterLast(t *testing.T) {
+	nodes := []*types.Node{
+		&types.Node{
+			ID:   "Ab1cD2eFG3hIJ4kLMnOpQr",
+			Name: "node-a",
+		},
+		&types.Node{
+			ID:   "Bc2dE3fGH4iJK5lMNoPqRs",
+			Name: "node-b",
+		},
+		&types.Node{
+			ID:   "Cd3eF4gHI5jKL6mNOpQrSt",
+			Name: "node-c",
+		},
+	}
+
+	testcases := []struct {
+		nodeNames    []string
+		masterNodeID string
+		expected     []string
+	}{
+		{
+			nodeNames:    []string{"node-a", "node-b", "node-c"},
+			masterNodeID: "Ab1cD2eFG3hIJ4kLMnOpQr",
+			expected:     []string{"node-b", "node-c", "node-a"},
+		},
+		{
+			nodeNames:    []string{"node-a", "node-b", "node-c"},
+			masterNodeID: "Cd3eF4gHI5jKL6mNOpQrSt",
+			expected:     []string{"node-a", "node-b", "node-c"},
+		},
+		{
+			nodeNames:    []string{"node-a", "node-c"},
+			masterNodeID: "Bc2dE3fGH4iJK5lMNoPqRs",
+			expected:     []string{"node-a", "node-c"},
+		},
+	}
+
+	for _, tc := range testcases {
+		got := electedMasterLast(nodes, tc.nodeNames, tc.masterNodeID)
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("node names do not match. expected: %q, got: %q", tc.expected, got)
+		}
+	}
+}
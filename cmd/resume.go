@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"github.com/dtan4/esnctl/state"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// resumeCmd represents the resume command
+var resumeCmd = &cobra.Command{
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	Use:           "resume RUNID",
+	Short:         "Resume interrupted add / remove / replace / restart / scale run",
+	RunE:          doResume,
+}
+
+var resumeOpts = struct {
+	stateDir string
+}{}
+
+func doResume(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("run ID must be specified")
+	}
+
+	stateDir := resumeOpts.stateDir
+
+	if stateDir == "" {
+		dir, err := state.DefaultDir()
+		if err != nil {
+			return errors.Wrap(err, "failed to detect state directory")
+		}
+
+		stateDir = dir
+	}
+
+	run, err := state.Load(stateDir, args[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to load state")
+	}
+
+	ctx, stopHandlingSignals := newSignalContext()
+	defer stopHandlingSignals()
+
+	switch run.Command {
+	case "add":
+		return resumeAdd(ctx, run)
+	case "remove":
+		return resumeRemove(ctx, run)
+	case "replace":
+		return resumeReplace(ctx, run)
+	case "restart":
+		return resumeRestart(ctx, run)
+	case "scale":
+		return resumeScale(ctx, run)
+	}
+
+	return errors.Errorf("command %q cannot be resumed", run.Command)
+}
+
+func init() {
+	RootCmd.AddCommand(resumeCmd)
+
+	resumeCmd.Flags().StringVar(&resumeOpts.stateDir, "state-dir", "", "Directory where progress of runs is stored (default: ~/.esnctl/runs)")
+}
@@ -9,10 +9,15 @@ import (
 
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
-	Use:   "esnctl",
-	Short: "A brief description of your application",
+	Use:               "esnctl",
+	Short:             "A brief description of your application",
+	PersistentPreRunE: validateOutput,
 }
 
+var rootOpts = struct {
+	output string
+}{}
+
 // Execute adds all child commands to the root command sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -29,6 +34,8 @@ func Execute() {
 
 func init() {
 	cobra.OnInitialize(initConfig)
+
+	RootCmd.PersistentFlags().StringVarP(&rootOpts.output, "output", "o", outputText, "Output format (text, json or yaml)")
 }
 
 // initConfig reads in config file and ENV variables if set.
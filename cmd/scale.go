@@ -0,0 +1,286 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dtan4/esnctl/aws"
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/state"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	scaleActionAdd    = "add"
+	scaleActionRemove = "remove"
+)
+
+const (
+	scaleValueAction           = "action"
+	scaleValueAutoScalingGroup = "group"
+	scaleValueClusterURL       = "cluster_url"
+	scaleValueDelta            = "delta"
+	scaleValueForce            = "force"
+	scaleValueRegion           = "region"
+	scaleValueRollback         = "rollback_on_failure"
+)
+
+// scaleCmd represents the scale command
+var scaleCmd = &cobra.Command{
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	Use:           "scale",
+	Short:         "Scale Elasticsearch cluster to the given number of nodes",
+	RunE:          doScale,
+}
+
+var scaleOpts = struct {
+	autoScalingGroup string
+	az               string
+	clusterURL       string
+	dryRun           bool
+	excludeBy        string
+	force            bool
+	policy           string
+	region           string
+	rollback         bool
+	stateDir         string
+	to               int
+	wait             waitConfig
+}{
+	wait: defaultWaitConfig(),
+}
+
+func doScale(cmd *cobra.Command, args []string) error {
+	if scaleOpts.clusterURL == "" {
+		return errors.New("Elasticsearch cluster URL (--cluster-url) must be specified")
+	}
+
+	if scaleOpts.autoScalingGroup == "" {
+		return errors.New("Auto Scaling Group (--group) must be specified")
+	}
+
+	if scaleOpts.to < 1 {
+		return errors.New("number of nodes (--to) must be greater than 0")
+	}
+
+	if err := scaleOpts.wait.validate(); err != nil {
+		return err
+	}
+
+	ctx, stopHandlingSignals := newSignalContext()
+	defer stopHandlingSignals()
+
+	policy, err := newVictimPolicy(scaleOpts.policy, scaleOpts.az)
+	if err != nil {
+		return err
+	}
+
+	if err := aws.Initialize(scaleOpts.region); err != nil {
+		return errors.Wrap(err, "failed to initialize AWS service clients")
+	}
+
+	httpClient := &http.Client{}
+
+	if scaleOpts.dryRun {
+		httpClient, _ = newDryRunHTTPClient()
+	}
+
+	client, err := es.New(scaleOpts.clusterURL, httpClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	currentDesiredCapacity, err := aws.AutoScaling.RetrieveDesiredCapacity(ctx, scaleOpts.autoScalingGroup)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve desired capacity")
+	}
+
+	if currentDesiredCapacity == scaleOpts.to {
+		log.Printf("===> %s already has %d instances, nothing to do\n", scaleOpts.autoScalingGroup, scaleOpts.to)
+		return nil
+	}
+
+	values := map[string]string{
+		scaleValueAutoScalingGroup: scaleOpts.autoScalingGroup,
+		scaleValueClusterURL:       scaleOpts.clusterURL,
+		scaleValueForce:            strconv.FormatBool(scaleOpts.force),
+		scaleValueRegion:           scaleOpts.region,
+		scaleValueRollback:         strconv.FormatBool(scaleOpts.rollback),
+	}
+	scaleOpts.wait.setValues(values)
+
+	var victims []*candidate
+
+	if scaleOpts.to > currentDesiredCapacity {
+		values[scaleValueAction] = scaleActionAdd
+		values[scaleValueDelta] = strconv.Itoa(scaleOpts.to - currentDesiredCapacity)
+	} else {
+		candidates, err := listCandidates(ctx, client, scaleOpts.autoScalingGroup)
+		if err != nil {
+			return errors.Wrap(err, "failed to list nodes which can be removed")
+		}
+
+		victims, err = selectVictims(candidates, policy, currentDesiredCapacity-scaleOpts.to)
+		if err != nil {
+			return errors.Wrap(err, "failed to select nodes to remove")
+		}
+
+		instanceIDs := []string{}
+
+		for _, victim := range victims {
+			instanceIDs = append(instanceIDs, victim.instance.ID)
+		}
+
+		excludeBy := scaleOpts.excludeBy
+		if excludeBy == "" {
+			excludeBy = defaultExcludeAttribute(targetByInstanceID)
+		}
+
+		values[scaleValueAction] = scaleActionRemove
+		values[removeValueExcludeBy] = excludeBy
+		values[removeValueTargetBy] = targetByInstanceID
+		values[removeValueTargets] = joinValues(instanceIDs)
+	}
+
+	if scaleOpts.dryRun {
+		return planScale(ctx, client, currentDesiredCapacity, values, victims)
+	}
+
+	run, err := newRun(scaleOpts.stateDir, "scale", values)
+	if err != nil {
+		return err
+	}
+
+	return runScale(ctx, run)
+}
+
+func resumeScale(ctx context.Context, run *state.Run) error {
+	wait, err := loadWaitConfig(run)
+	if err != nil {
+		return err
+	}
+
+	scaleOpts.autoScalingGroup = run.Get(scaleValueAutoScalingGroup)
+	scaleOpts.clusterURL = run.Get(scaleValueClusterURL)
+	scaleOpts.force = run.Get(scaleValueForce) == "true"
+	scaleOpts.region = run.Get(scaleValueRegion)
+	scaleOpts.rollback = run.Get(scaleValueRollback) == "true"
+	scaleOpts.wait = wait
+
+	return runScale(ctx, run)
+}
+
+func runScale(ctx context.Context, run *state.Run) error {
+	httpClient := &http.Client{}
+
+	client, err := es.New(scaleOpts.clusterURL, httpClient)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Elasitcsearch API client")
+	}
+
+	if err := aws.Initialize(scaleOpts.region); err != nil {
+		return errors.Wrap(err, "failed to initialize AWS service clients")
+	}
+
+	steps, err := scaleSteps(run, client)
+	if err != nil {
+		return err
+	}
+
+	restoreReallocation := newReallocationRestorer(run, client, "")
+
+	if err := runSteps(ctx, run, steps, scaleOpts.rollback); err != nil {
+		restoreReallocation()
+		return err
+	}
+
+	return nil
+}
+
+// scaleSteps returns the steps to add or remove nodes, decided when the run started
+func scaleSteps(run *state.Run, client es.Client) ([]step, error) {
+	steps := []step{
+		checkClusterHealthStep(client, scaleOpts.force),
+	}
+
+	switch run.Get(scaleValueAction) {
+	case scaleActionAdd:
+		delta, err := strconv.Atoi(run.Get(scaleValueDelta))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid number to add instances in state file")
+		}
+
+		return append(steps, addSteps(run, client, "", scaleOpts.autoScalingGroup, delta, "", scaleOpts.wait)...), nil
+	case scaleActionRemove:
+		return append(steps, removeSteps(run, client, "", scaleOpts.autoScalingGroup, scaleOpts.force, scaleOpts.wait)...), nil
+	}
+
+	return nil, errors.Errorf("invalid action %q in state file", run.Get(scaleValueAction))
+}
+
+// planScale prints what runScale will do
+func planScale(ctx context.Context, client es.Client, currentDesiredCapacity int, values map[string]string, victims []*candidate) error {
+	if err := checkClusterHealth(ctx, client, scaleOpts.force); err != nil {
+		return err
+	}
+
+	// Steps are built only to print their descriptions, so the run is never saved
+	run := &state.Run{
+		Values: values,
+	}
+
+	steps, err := scaleSteps(run, client)
+	if err != nil {
+		return err
+	}
+
+	log.Println("===> Dry run: no changes will be made")
+
+	fmt.Printf("Auto Scaling Group: %s\n", scaleOpts.autoScalingGroup)
+	fmt.Printf("DesiredCapacity: %d -> %d\n", currentDesiredCapacity, scaleOpts.to)
+
+	if len(victims) > 0 {
+		fmt.Printf("Nodes to remove (policy: %s):\n", scaleOpts.policy)
+
+		for _, victim := range victims {
+			fmt.Printf("  - %s (instance: %s, az: %s, launched: %s, shards: %d)\n",
+				victim.node.Name, victim.instance.ID, victim.instance.AvailabilityZone, victim.instance.LaunchTime.Format(time.RFC3339), victim.shards)
+		}
+	}
+
+	fmt.Println("Steps:")
+
+	for i, s := range steps {
+		printPlanStep(i+1, s.description, []string{})
+	}
+
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(scaleCmd)
+
+	scaleCmd.Flags().StringVar(&scaleOpts.autoScalingGroup, "group", "", "Auto Scaling Group")
+	scaleCmd.Flags().StringVar(&scaleOpts.az, "az", "", "Availability Zone to remove nodes from, used with --policy=az")
+	scaleCmd.Flags().BoolVar(&scaleOpts.wait.backoff, "backoff", false, "Double poll interval after each poll, up to 1m")
+	scaleCmd.Flags().StringVar(&scaleOpts.clusterURL, "cluster-url", "", "Elasticsearch cluster URL")
+	scaleCmd.Flags().DurationVar(&scaleOpts.wait.deregisterTimeout, "deregister-timeout", defaultDeregisterTimeout, "Time to wait for instances to be deregistered from load balancer")
+	scaleCmd.Flags().DurationVar(&scaleOpts.wait.drainTimeout, "drain-timeout", defaultDrainTimeout, "Time to wait for shards to escape from removed nodes")
+	scaleCmd.Flags().BoolVar(&scaleOpts.dryRun, "dry-run", false, "Print what will be done without making any changes")
+	scaleCmd.Flags().StringVar(&scaleOpts.excludeBy, "exclude-by", "", "Allocation filter attribute to exclude nodes by, e.g. _name, _ip, _id or aws_instance_id (default: _ip)")
+	scaleCmd.Flags().BoolVar(&scaleOpts.force, "force", false, "Start even if the cluster is not green, and remove nodes even if the remaining nodes lack disk capacity")
+	scaleCmd.Flags().DurationVar(&scaleOpts.wait.healthTimeout, "health-timeout", defaultHealthTimeout, "Time to wait for the cluster to be green after enabling reallocation")
+	scaleCmd.Flags().DurationVar(&scaleOpts.wait.joinTimeout, "join-timeout", defaultJoinTimeout, "Time to wait for added nodes to join the cluster")
+	scaleCmd.Flags().StringVar(&scaleOpts.policy, "policy", victimPolicyBalanced, "Policy to select nodes to remove (balanced, fewest-shards, oldest-instance, az)")
+	scaleCmd.Flags().DurationVar(&scaleOpts.wait.pollInterval, "poll-interval", defaultPollInterval, "Interval to poll Elasticsearch and AWS while waiting")
+	scaleCmd.Flags().StringVar(&scaleOpts.region, "region", "", "AWS region")
+	scaleCmd.Flags().BoolVar(&scaleOpts.rollback, "rollback-on-failure", false, "Undo the completed steps if scaling fails")
+	scaleCmd.Flags().StringVar(&scaleOpts.stateDir, "state-dir", "", "Directory to store progress of this run (default: ~/.esnctl/runs)")
+	scaleCmd.Flags().IntVar(&scaleOpts.to, "to", 0, "Number of instances the Auto Scaling Group should have")
+}
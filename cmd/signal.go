@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// newSignalContext returns a context which is cancelled when SIGINT or SIGTERM is received
+// In-flight requests and waits are aborted, so that the caller can clean up before exiting.
+// Receiving the signal again exits immediately. Returned function stops handling signals
+func newSignalContext() (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sigCh := make(chan os.Signal, 2)
+	doneCh := make(chan struct{})
+
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case sig := <-sigCh:
+			log.Printf("===> Received %s, cleaning up... (send again to exit immediately)\n", sig)
+			cancel()
+		case <-doneCh:
+			return
+		}
+
+		select {
+		case sig := <-sigCh:
+			log.Printf("===> Received %s, exiting without cleanup\n", sig)
+			os.Exit(1)
+		case <-doneCh:
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(sigCh)
+		close(doneCh)
+		cancel()
+	}
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/dtan4/esnctl/aws"
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	targetByInstanceID = "instance-id"
+	targetByNodeID     = "node-id"
+	targetByNodeIP     = "node-ip"
+	targetByNodeName   = "node-name"
+)
+
+// defaultExcludeAttribute returns the allocation filter attribute used for the given kind of targets
+func defaultExcludeAttribute(targetBy string) string {
+	switch targetBy {
+	case targetByNodeID:
+		return "_id"
+	case targetByNodeIP, targetByInstanceID:
+		return "_ip"
+	}
+
+	return "_name"
+}
+
+// selectTargets returns the kind of target nodes and their values given by flags
+// Exactly one of --node-name, --node-ip, --node-id and --instance-id must be specified
+func selectTargets(nodeNames, nodeIPs, nodeIDs, instanceIDs []string) (string, []string, error) {
+	targetBy := ""
+	targets := []string{}
+
+	for _, t := range []struct {
+		targetBy string
+		values   []string
+	}{
+		{targetByNodeName, nodeNames},
+		{targetByNodeIP, nodeIPs},
+		{targetByNodeID, nodeIDs},
+		{targetByInstanceID, instanceIDs},
+	} {
+		if len(t.values) == 0 {
+			continue
+		}
+
+		if targetBy != "" {
+			return "", nil, errors.Errorf("--%s and --%s cannot be specified at the same time", targetBy, t.targetBy)
+		}
+
+		targetBy = t.targetBy
+		targets = t.values
+	}
+
+	if targetBy == "" {
+		return "", nil, errors.New("target nodes (--node-name, --node-ip, --node-id or --instance-id) must be specified")
+	}
+
+	return targetBy, targets, nil
+}
+
+// resolveTargets maps the given targets to Elasticsearch nodes and EC2 instance IDs running them
+// Every resolved instance must belong to the given Auto Scaling Group
+func resolveTargets(ctx context.Context, client es.Client, group string, targetBy string, targets []string) ([]*types.Node, []string, error) {
+	nodes, err := client.DescribeNodes(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to describe nodes")
+	}
+
+	members, err := aws.AutoScaling.ListInstances(ctx, group)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list instances in %q", group)
+	}
+
+	if targetBy == targetByInstanceID {
+		privateIPs := []string{}
+
+		for _, instanceID := range targets {
+			if !contains(members, instanceID) {
+				return nil, nil, errors.Errorf("instance %q does not belong to %q", instanceID, group)
+			}
+
+			privateIP, err := aws.EC2.RetrievePrivateIPFromInstanceID(ctx, instanceID)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to retrieve private IP address of %q", instanceID)
+			}
+
+			privateIPs = append(privateIPs, privateIP)
+		}
+
+		selected, err := selectNodes(nodes, targetByNodeIP, privateIPs)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return selected, targets, nil
+	}
+
+	selected, err := selectNodes(nodes, targetBy, targets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	instanceIDs := []string{}
+
+	for _, node := range selected {
+		instanceID, err := aws.EC2.RetrieveInstanceIDFromPrivateIP(ctx, node.IP)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to retrieve instance ID of %q", node.Name)
+		}
+
+		if !contains(members, instanceID) {
+			return nil, nil, errors.Errorf("instance %q running %q does not belong to %q", instanceID, node.Name, group)
+		}
+
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+
+	return selected, instanceIDs, nil
+}
+
+// selectNodes returns the nodes matching to the given targets, in the same order as targets
+func selectNodes(nodes []*types.Node, targetBy string, targets []string) ([]*types.Node, error) {
+	selected := []*types.Node{}
+
+	for _, target := range targets {
+		var found *types.Node
+
+		for _, node := range nodes {
+			if nodeKey(node, targetBy) == target {
+				found = node
+				break
+			}
+		}
+
+		if found == nil {
+			return nil, errors.Errorf("node with %s %q not found", targetBy, target)
+		}
+
+		selected = append(selected, found)
+	}
+
+	return selected, nil
+}
+
+// filterNodesByRole returns the nodes having the given role
+// All nodes are returned if role is empty
+func filterNodesByRole(nodes []*types.Node, role string) []*types.Node {
+	if role == "" {
+		return nodes
+	}
+
+	filtered := []*types.Node{}
+
+	for _, node := range nodes {
+		if node.HasRole(role) {
+			filtered = append(filtered, node)
+		}
+	}
+
+	return filtered
+}
+
+// checkRole returns error if any of the given nodes does not have the given role
+// Nothing is checked if role is empty
+func checkRole(nodes []*types.Node, role string) error {
+	if role == "" {
+		return nil
+	}
+
+	for _, node := range nodes {
+		if !node.HasRole(role) {
+			return errors.Errorf("node %q does not have role %q (roles: %s)", node.Name, role, joinValues(node.Roles))
+		}
+	}
+
+	return nil
+}
+
+// excludeValues returns the values of the given allocation filter attribute of nodes
+func excludeValues(nodes []*types.Node, attribute string) ([]string, error) {
+	values := []string{}
+
+	for _, node := range nodes {
+		value := node.AllocationFilterValue(attribute)
+		if value == "" {
+			return nil, errors.Errorf("node %q does not have attribute %q", node.Name, attribute)
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func nodeKey(node *types.Node, targetBy string) string {
+	switch targetBy {
+	case targetByNodeID:
+		return node.ID
+	case targetByNodeIP:
+		return node.IP
+	}
+
+	return node.Name
+}
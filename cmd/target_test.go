@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dtan4/esnctl/es/types"
+)
+
+var testNodes = []*types.Node{
+	&types.Node{
+		ID:         "Ab1cD2eFG3hIJ4kLMnOpQr",
+		Name:       "node-a",
+		IP:         "10.0.1.23",
+		Attributes: map[string]string{},
+	},
+	&types.Node{
+		ID:   "Zy9xW8vUT7sRQ6pONmLkJi",
+		Name: "node-b",
+		IP:   "10.0.1.24",
+		Attributes: map[string]string{
+			"aws_instance_id": "i-5678efab",
+		},
+	},
+}
+
+func TestSelectNodes(t *testing.T) {
+	testcases := []struct {
+		targetBy string
+		targets  []string
+		expected []*types.Node
+	}{
+		{
+			targetBy: targetByNodeName,
+			targets:  []string{"node-b", "node-a"},
+			expected: []*types.Node{testNodes[1], testNodes[0]},
+		},
+		{
+			targetBy: targetByNodeIP,
+			targets:  []string{"10.0.1.24"},
+			expected: []*types.Node{testNodes[1]},
+		},
+		{
+			targetBy: targetByNodeID,
+			targets:  []string{"Ab1cD2eFG3hIJ4kLMnOpQr"},
+			expected: []*types.Node{testNodes[0]},
+		},
+	}
+
+	for _, tc := range testcases {
+		got, err := selectNodes(testNodes, tc.targetBy, tc.targets)
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+		}
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("nodes does not match. expected: %#v, got: %#v", tc.expected, got)
+		}
+	}
+}
+
+func TestSelectNodes_notFound(t *testing.T) {
+	_, err := selectNodes(testNodes, targetByNodeIP, []string{"10.0.1.25"})
+	if err == nil {
+		t.Errorf("error should be raised")
+	}
+}
+
+func TestExcludeValues(t *testing.T) {
+	got, err := excludeValues(testNodes[1:], "aws_instance_id")
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	expected := []string{"i-5678efab"}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("values does not match. expected: %q, got: %q", expected, got)
+	}
+
+	if _, err := excludeValues(testNodes, "aws_instance_id"); err == nil {
+		t.Errorf("error should be raised")
+	}
+}
+
+func TestFilterNodesByRole(t *testing.T) {
+	nodes := []*types.Node{
+		&types.Node{Name: "master-a", Roles: []string{"master"}},
+		&types.Node{Name: "data-a", Roles: []string{"data_hot", "ingest"}},
+		&types.Node{Name: "coordinating-a", Roles: []string{}},
+	}
+
+	testcases := []struct {
+		role     string
+		expected []*types.Node
+	}{
+		{
+			role:     "",
+			expected: nodes,
+		},
+		{
+			role:     "data",
+			expected: []*types.Node{nodes[1]},
+		},
+		{
+			role:     "ml",
+			expected: []*types.Node{},
+		},
+	}
+
+	for _, tc := range testcases {
+		if got := filterNodesByRole(nodes, tc.role); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("nodes does not match. expected: %#v, got: %#v", tc.expected, got)
+		}
+	}
+
+	if err := checkRole(nodes[1:2], "data"); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if err := checkRole(nodes, "data"); err == nil {
+		t.Errorf("error should be raised for nodes without the role")
+	}
+}
+
+func TestSelectTargets(t *testing.T) {
+	targetBy, targets, err := selectTargets([]string{}, []string{"10.0.1.23", "10.0.1.24"}, []string{}, []string{})
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if targetBy != targetByNodeIP {
+		t.Errorf("target kind does not match. expected: %q, got: %q", targetByNodeIP, targetBy)
+	}
+
+	expected := []string{"10.0.1.23", "10.0.1.24"}
+
+	if !reflect.DeepEqual(targets, expected) {
+		t.Errorf("targets does not match. expected: %q, got: %q", expected, targets)
+	}
+
+	if _, _, err := selectTargets([]string{"node-a"}, []string{}, []string{}, []string{"i-1234abcd"}); err == nil {
+		t.Errorf("error should be raised")
+	}
+
+	if _, _, err := selectTargets([]string{}, []string{}, []string{}, []string{}); err == nil {
+		t.Errorf("error should be raised")
+	}
+}
@@ -0,0 +1,21 @@
+package cmd
+
+func contains(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsAny(values, targets []string) bool {
+	for _, target := range targets {
+		if contains(values, target) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dtan4/esnctl/aws"
+	"github.com/dtan4/esnctl/aws/ec2"
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	victimPolicyAZ             = "az"
+	victimPolicyBalanced       = "balanced"
+	victimPolicyFewestShards   = "fewest-shards"
+	victimPolicyOldestInstance = "oldest-instance"
+)
+
+// candidate represents Elasticsearch node running in Auto Scaling Group
+type candidate struct {
+	node          *types.Node
+	instance      *ec2.Instance
+	shards        int
+	diskUsed      int64
+	electedMaster bool
+}
+
+// victimPolicy sorts candidates so that nodes to be removed first come first
+type victimPolicy func(candidates []*candidate)
+
+// newVictimPolicy returns the victim selection policy of the given name
+// az is used only by the az policy, which prefers nodes in the given Availability Zone
+func newVictimPolicy(name, az string) (victimPolicy, error) {
+	switch name {
+	case victimPolicyBalanced:
+		return balancedPolicy, nil
+	case victimPolicyFewestShards:
+		return func(candidates []*candidate) {
+			sort.SliceStable(candidates, func(i, j int) bool {
+				return candidates[i].shards < candidates[j].shards
+			})
+		}, nil
+	case victimPolicyOldestInstance:
+		return func(candidates []*candidate) {
+			sort.SliceStable(candidates, func(i, j int) bool {
+				return candidates[i].instance.LaunchTime.Before(candidates[j].instance.LaunchTime)
+			})
+		}, nil
+	case victimPolicyAZ:
+		if az == "" {
+			return nil, errors.New("Availability Zone (--az) must be specified to use az policy")
+		}
+
+		return func(candidates []*candidate) {
+			sort.SliceStable(candidates, func(i, j int) bool {
+				iInAZ := candidates[i].instance.AvailabilityZone == az
+				jInAZ := candidates[j].instance.AvailabilityZone == az
+
+				if iInAZ != jInAZ {
+					return iInAZ
+				}
+
+				return candidates[i].shards < candidates[j].shards
+			})
+		}, nil
+	}
+
+	return nil, errors.Errorf("unknown victim selection policy %q", name)
+}
+
+// balancedPolicy keeps Availability Zones balanced, by taking nodes from the Availability Zone having the most
+// nodes one by one. Among them, nodes which are not master eligible, have fewer shards, use less disk and run
+// on older instances are taken first.
+func balancedPolicy(candidates []*candidate) {
+	azCounts := map[string]int{}
+
+	for _, c := range candidates {
+		azCounts[c.instance.AvailabilityZone]++
+	}
+
+	remaining := []*candidate{}
+	masters := []*candidate{}
+
+	// Elected master counts for Availability Zone balance, but is never taken
+	for _, c := range candidates {
+		if c.electedMaster {
+			masters = append(masters, c)
+		} else {
+			remaining = append(remaining, c)
+		}
+	}
+
+	ordered := []*candidate{}
+
+	for len(remaining) > 0 {
+		sort.SliceStable(remaining, func(i, j int) bool {
+			a, b := remaining[i], remaining[j]
+
+			if azCounts[a.instance.AvailabilityZone] != azCounts[b.instance.AvailabilityZone] {
+				return azCounts[a.instance.AvailabilityZone] > azCounts[b.instance.AvailabilityZone]
+			}
+
+			if a.node.MasterEligible != b.node.MasterEligible {
+				return !a.node.MasterEligible
+			}
+
+			if a.shards != b.shards {
+				return a.shards < b.shards
+			}
+
+			if a.diskUsed != b.diskUsed {
+				return a.diskUsed < b.diskUsed
+			}
+
+			return a.instance.LaunchTime.Before(b.instance.LaunchTime)
+		})
+
+		azCounts[remaining[0].instance.AvailabilityZone]--
+		ordered = append(ordered, remaining[0])
+		remaining = remaining[1:]
+	}
+
+	copy(candidates, append(ordered, masters...))
+}
+
+// listCandidates returns Elasticsearch nodes running on the instances in the given Auto Scaling Group
+func listCandidates(ctx context.Context, client es.Client, group string) ([]*candidate, error) {
+	instanceIDs, err := aws.AutoScaling.ListInstances(ctx, group)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list instances in AutoScaling Group")
+	}
+
+	if len(instanceIDs) == 0 {
+		return []*candidate{}, nil
+	}
+
+	instances, err := aws.EC2.DescribeInstances(ctx, instanceIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe instances")
+	}
+
+	nodes, err := client.DescribeNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe nodes")
+	}
+
+	allocations, err := client.ListAllocations(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list allocations")
+	}
+
+	masterNodeID, err := client.MasterNodeID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve master node")
+	}
+
+	candidates := []*candidate{}
+
+	for _, node := range nodes {
+		for _, instance := range instances {
+			if node.IP != instance.PrivateIP {
+				continue
+			}
+
+			c := &candidate{
+				node:          node,
+				instance:      instance,
+				electedMaster: node.ID == masterNodeID,
+			}
+
+			for _, allocation := range allocations {
+				if allocation.NodeName == node.Name {
+					c.shards = allocation.Shards
+					c.diskUsed = allocation.DiskUsedBytes
+					break
+				}
+			}
+
+			candidates = append(candidates, c)
+
+			break
+		}
+	}
+
+	return candidates, nil
+}
+
+// filterCandidatesByRole returns the candidates whose node has the given role
+func filterCandidatesByRole(candidates []*candidate, role string) []*candidate {
+	filtered := []*candidate{}
+
+	for _, c := range candidates {
+		if c.node.HasRole(role) {
+			filtered = append(filtered, c)
+		}
+	}
+
+	return filtered
+}
+
+// selectVictims returns n candidates chosen by the given policy
+// Elected master is never chosen whatever the policy is
+func selectVictims(candidates []*candidate, policy victimPolicy, n int) ([]*candidate, error) {
+	sorted := make([]*candidate, len(candidates))
+	copy(sorted, candidates)
+
+	policy(sorted)
+
+	victims := []*candidate{}
+
+	for _, c := range sorted {
+		if c.electedMaster {
+			continue
+		}
+
+		victims = append(victims, c)
+	}
+
+	if len(victims) < n {
+		return nil, errors.Errorf("only %d nodes can be removed, but %d nodes must be removed", len(victims), n)
+	}
+
+	return victims[:n], nil
+}
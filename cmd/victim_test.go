@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dtan4/esnctl/aws/ec2"
+	"github.com/dtan4/esnctl/es/types"
+)
+
+var testCandidates = []*candidate{
+	&candidate{
+		node: &types.Node{Name: "node-a"},
+		instance: &ec2.Instance{
+			ID:               "i-1111aaaa",
+			AvailabilityZone: "ap-northeast-1a",
+			LaunchTime:       time.Date(2017, 3, 2, 0, 0, 0, 0, time.UTC),
+		},
+		shards: 10,
+	},
+	&candidate{
+		node: &types.Node{Name: "node-b"},
+		instance: &ec2.Instance{
+			ID:               "i-2222bbbb",
+			AvailabilityZone: "ap-northeast-1c",
+			LaunchTime:       time.Date(2017, 3, 3, 0, 0, 0, 0, time.UTC),
+		},
+		shards: 5,
+	},
+	&candidate{
+		node: &types.Node{Name: "node-c"},
+		instance: &ec2.Instance{
+			ID:               "i-3333cccc",
+			AvailabilityZone: "ap-northeast-1a",
+			LaunchTime:       time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		shards: 8,
+	},
+}
+
+func TestSelectVictims(t *testing.T) {
+	testcases := []struct {
+		policy   string
+		az       string
+		n        int
+		expected []*candidate
+	}{
+		{
+			policy:   victimPolicyFewestShards,
+			n:        2,
+			expected: []*candidate{testCandidates[1], testCandidates[2]},
+		},
+		{
+			policy:   victimPolicyOldestInstance,
+			n:        2,
+			expected: []*candidate{testCandidates[2], testCandidates[0]},
+		},
+		{
+			policy:   victimPolicyBalanced,
+			n:        2,
+			expected: []*candidate{testCandidates[2], testCandidates[1]},
+		},
+		{
+			policy:   victimPolicyAZ,
+			az:       "ap-northeast-1a",
+			n:        1,
+			expected: []*candidate{testCandidates[2]},
+		},
+		{
+			policy:   victimPolicyAZ,
+			az:       "ap-northeast-1c",
+			n:        2,
+			expected: []*candidate{testCandidates[1], testCandidates[2]},
+		},
+	}
+
+	for _, tc := range testcases {
+		policy, err := newVictimPolicy(tc.policy, tc.az)
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+			continue
+		}
+
+		got, err := selectVictims(testCandidates, policy, tc.n)
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+		}
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("victims does not match. policy: %s, expected: %#v, got: %#v", tc.policy, tc.expected, got)
+		}
+	}
+}
+
+func TestSelectVictims_electedMaster(t *testing.T) {
+	master := *testCandidates[2]
+	master.electedMaster = true
+
+	candidates := []*candidate{testCandidates[0], testCandidates[1], &master}
+
+	testcases := []struct {
+		policy   string
+		n        int
+		expected []*candidate
+	}{
+		{
+			policy:   victimPolicyFewestShards,
+			n:        2,
+			expected: []*candidate{testCandidates[1], testCandidates[0]},
+		},
+		{
+			// Elected master still counts for Availability Zone balance
+			policy:   victimPolicyBalanced,
+			n:        1,
+			expected: []*candidate{testCandidates[0]},
+		},
+	}
+
+	for _, tc := range testcases {
+		policy, err := newVictimPolicy(tc.policy, "")
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+			continue
+		}
+
+		got, err := selectVictims(candidates, policy, tc.n)
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+		}
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("victims does not match. policy: %s, expected: %#v, got: %#v", tc.policy, tc.expected, got)
+		}
+	}
+
+	policy, err := newVictimPolicy(victimPolicyFewestShards, "")
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	if _, err := selectVictims(candidates, policy, 3); err == nil {
+		t.Errorf("error should be raised")
+	}
+}
+
+func TestSelectVictims_tooMany(t *testing.T) {
+	policy, err := newVictimPolicy(victimPolicyFewestShards, "")
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	if _, err := selectVictims(testCandidates, policy, 4); err == nil {
+		t.Errorf("error should be raised")
+	}
+}
+
+func TestNewVictimPolicy_invalid(t *testing.T) {
+	if _, err := newVictimPolicy("random", ""); err == nil {
+		t.Errorf("error should be raised")
+	}
+
+	if _, err := newVictimPolicy(victimPolicyAZ, ""); err == nil {
+		t.Errorf("error should be raised")
+	}
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dtan4/esnctl/state"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultDeregisterTimeout = 5 * time.Minute
+	defaultDrainTimeout      = 5 * time.Minute
+	defaultJoinTimeout       = 10 * time.Minute
+	defaultPollInterval      = 5 * time.Second
+
+	// maxPollInterval caps poll interval growing by exponential backoff
+	maxPollInterval = time.Minute
+)
+
+const (
+	waitValueBackoff           = "backoff"
+	waitValueDeregisterTimeout = "deregister_timeout"
+	waitValueDrainTimeout      = "drain_timeout"
+	waitValueHealthTimeout     = "health_timeout"
+	waitValueJoinTimeout       = "join_timeout"
+	waitValuePollInterval      = "poll_interval"
+)
+
+// errWaitTimeout is returned by waitUntil if the condition is not satisfied within timeout
+var errWaitTimeout = errors.New("timed out")
+
+// sleep is replaced in tests
+var sleep = sleepContext
+
+// waitConfig represents how long and how often the steps waiting for Elasticsearch and AWS poll them
+type waitConfig struct {
+	backoff           bool
+	deregisterTimeout time.Duration
+	drainTimeout      time.Duration
+	healthTimeout     time.Duration
+	joinTimeout       time.Duration
+	pollInterval      time.Duration
+}
+
+// defaultWaitConfig returns the config used for waiting steps whose flags are not provided by the command
+func defaultWaitConfig() waitConfig {
+	return waitConfig{
+		deregisterTimeout: defaultDeregisterTimeout,
+		drainTimeout:      defaultDrainTimeout,
+		healthTimeout:     defaultHealthTimeout,
+		joinTimeout:       defaultJoinTimeout,
+		pollInterval:      defaultPollInterval,
+	}
+}
+
+// validate returns error if any timeout or poll interval is not positive
+func (c waitConfig) validate() error {
+	durations := []struct {
+		flag  string
+		value time.Duration
+	}{
+		{"--deregister-timeout", c.deregisterTimeout},
+		{"--drain-timeout", c.drainTimeout},
+		{"--health-timeout", c.healthTimeout},
+		{"--join-timeout", c.joinTimeout},
+		{"--poll-interval", c.pollInterval},
+	}
+
+	for _, d := range durations {
+		if d.value <= 0 {
+			return errors.Errorf("%s must be greater than 0", d.flag)
+		}
+	}
+
+	return nil
+}
+
+// setValues stores the config into the given values of run
+func (c waitConfig) setValues(values map[string]string) {
+	values[waitValueBackoff] = strconv.FormatBool(c.backoff)
+	values[waitValueDeregisterTimeout] = c.deregisterTimeout.String()
+	values[waitValueDrainTimeout] = c.drainTimeout.String()
+	values[waitValueHealthTimeout] = c.healthTimeout.String()
+	values[waitValueJoinTimeout] = c.joinTimeout.String()
+	values[waitValuePollInterval] = c.pollInterval.String()
+}
+
+// loadWaitConfig restores the config stored in the given run
+// Values missing in state files written by older versions are filled with defaults
+func loadWaitConfig(run *state.Run) (waitConfig, error) {
+	c := waitConfig{
+		backoff: run.Get(waitValueBackoff) == "true",
+	}
+
+	durations := []struct {
+		key          string
+		value        *time.Duration
+		defaultValue time.Duration
+	}{
+		{waitValueDeregisterTimeout, &c.deregisterTimeout, defaultDeregisterTimeout},
+		{waitValueDrainTimeout, &c.drainTimeout, defaultDrainTimeout},
+		{waitValueHealthTimeout, &c.healthTimeout, defaultHealthTimeout},
+		{waitValueJoinTimeout, &c.joinTimeout, defaultJoinTimeout},
+		{waitValuePollInterval, &c.pollInterval, defaultPollInterval},
+	}
+
+	for _, d := range durations {
+		if run.Get(d.key) == "" {
+			*d.value = d.defaultValue
+			continue
+		}
+
+		value, err := time.ParseDuration(run.Get(d.key))
+		if err != nil {
+			return waitConfig{}, errors.Wrapf(err, "invalid %s in state file", d.key)
+		}
+
+		*d.value = value
+	}
+
+	return c, nil
+}
+
+// waitUntil calls condition at every poll interval until it returns true, printing a dot at each poll unless --output is json or yaml
+// errWaitTimeout is returned if condition does not return true within timeout, and ctx.Err() if ctx is cancelled
+func (c waitConfig) waitUntil(ctx context.Context, timeout time.Duration, condition func() (bool, error)) error {
+	err := c.poll(ctx, timeout, func() (bool, error) {
+		ok, err := condition()
+		if err == nil && !ok && !structuredOutput() {
+			fmt.Print(".")
+		}
+
+		return ok, err
+	})
+	if (err == nil || err == ctx.Err()) && !structuredOutput() {
+		fmt.Print("\n")
+	}
+
+	return err
+}
+
+// poll calls condition at every poll interval until it returns true, without printing anything
+// Errors are the same as waitUntil
+func (c waitConfig) poll(ctx context.Context, timeout time.Duration, condition func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	interval := c.pollInterval
+
+	for {
+		ok, err := condition()
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			return nil
+		}
+
+		remaining := deadline.Sub(time.Now())
+		if remaining <= 0 {
+			return errWaitTimeout
+		}
+
+		d := interval
+		if d > remaining {
+			d = remaining
+		}
+
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+
+		if c.backoff {
+			interval = nextPollInterval(interval)
+		}
+	}
+}
+
+// sleepContext pauses for the given duration, or until ctx is cancelled
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// nextPollInterval doubles the given interval up to maxPollInterval
+// Interval already longer than maxPollInterval is kept as it is
+func nextPollInterval(interval time.Duration) time.Duration {
+	next := interval * 2
+
+	if next > maxPollInterval {
+		next = maxPollInterval
+	}
+
+	if next < interval {
+		return interval
+	}
+
+	return next
+}
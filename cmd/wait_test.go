@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dtan4/esnctl/state"
+	"github.com/pkg/errors"
+)
+
+func TestWaitUntil(t *testing.T) {
+	defer func() { sleep = sleepContext }()
+
+	slept := []time.Duration{}
+	sleep = func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+
+	c := waitConfig{
+		backoff:      true,
+		pollInterval: 20 * time.Second,
+	}
+
+	calls := 0
+
+	err := c.waitUntil(context.Background(), time.Hour, func() (bool, error) {
+		calls++
+		return calls == 4, nil
+	})
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	expected := []time.Duration{20 * time.Second, 40 * time.Second, time.Minute}
+
+	if !reflect.DeepEqual(slept, expected) {
+		t.Errorf("poll intervals does not match. expected: %v, got: %v", expected, slept)
+	}
+}
+
+func TestWaitUntil_timeout(t *testing.T) {
+	c := waitConfig{
+		pollInterval: time.Millisecond,
+	}
+
+	err := c.waitUntil(context.Background(), 10*time.Millisecond, func() (bool, error) {
+		return false, nil
+	})
+	if err != errWaitTimeout {
+		t.Errorf("timeout error should be raised, got: %v", err)
+	}
+}
+
+func TestWaitUntil_error(t *testing.T) {
+	c := waitConfig{
+		pollInterval: time.Millisecond,
+	}
+
+	err := c.waitUntil(context.Background(), time.Hour, func() (bool, error) {
+		return false, errors.New("connection refused")
+	})
+	if err == nil || err == errWaitTimeout {
+		t.Errorf("error of condition should be raised, got: %v", err)
+	}
+}
+
+func TestWaitUntil_cancelled(t *testing.T) {
+	c := waitConfig{
+		pollInterval: time.Hour,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := c.waitUntil(ctx, 2*time.Hour, func() (bool, error) {
+		cancel()
+		return false, nil
+	})
+	if err != context.Canceled {
+		t.Errorf("context.Canceled should be raised, got: %v", err)
+	}
+}
+
+func TestNextPollInterval(t *testing.T) {
+	testcases := []struct {
+		interval time.Duration
+		expected time.Duration
+	}{
+		{
+			interval: 5 * time.Second,
+			expected: 10 * time.Second,
+		},
+		{
+			interval: 40 * time.Second,
+			expected: time.Minute,
+		},
+		{
+			interval: 2 * time.Minute,
+			expected: 2 * time.Minute,
+		},
+	}
+
+	for _, tc := range testcases {
+		if got := nextPollInterval(tc.interval); got != tc.expected {
+			t.Errorf("poll interval does not match. interval: %s, expected: %s, got: %s", tc.interval, tc.expected, got)
+		}
+	}
+}
+
+func TestLoadWaitConfig(t *testing.T) {
+	c := defaultWaitConfig()
+	c.backoff = true
+	c.drainTimeout = 2 * time.Hour
+
+	values := map[string]string{}
+	c.setValues(values)
+
+	got, err := loadWaitConfig(&state.Run{Values: values})
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if got != c {
+		t.Errorf("wait config does not match. expected: %#v, got: %#v", c, got)
+	}
+
+	// State file written by older versions has only health timeout
+	got, err = loadWaitConfig(&state.Run{Values: map[string]string{waitValueHealthTimeout: "30m"}})
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	expected := defaultWaitConfig()
+	expected.healthTimeout = 30 * time.Minute
+
+	if got != expected {
+		t.Errorf("wait config does not match. expected: %#v, got: %#v", expected, got)
+	}
+
+	if _, err := loadWaitConfig(&state.Run{Values: map[string]string{waitValuePollInterval: "soon"}}); err == nil {
+		t.Errorf("error should be raised")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	c := defaultWaitConfig()
+
+	if err := c.validate(); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	c.pollInterval = 0
+
+	if err := c.validate(); err == nil {
+		t.Errorf("error should be raised")
+	}
+}
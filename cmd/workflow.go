@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/dtan4/esnctl/es"
+	"github.com/dtan4/esnctl/state"
+	"github.com/pkg/errors"
+)
+
+// step represents a discrete, idempotent step of add / remove workflow
+type step struct {
+	name        string
+	description string
+	run         func(ctx context.Context) error
+	// rollback undoes the changes made by run. nil if the step has nothing to undo
+	rollback func(ctx context.Context) error
+	// irreversible steps prevent all prior steps from being rolled back
+	irreversible bool
+}
+
+// runSteps runs the given steps in order, skipping steps already completed in the given run
+// If rollbackOnFailure is true, completed steps are rolled back in reverse order when a step fails
+// Steps are never rolled back if ctx is cancelled, so that the run can be resumed after being interrupted
+func runSteps(ctx context.Context, run *state.Run, steps []step, rollbackOnFailure bool) error {
+	log.Printf("===> Run ID: %s\n", run.ID)
+
+	for i, s := range steps {
+		if run.IsCompleted(s.name) {
+			log.Printf("===> %s... (skipped, already done)\n", s.description)
+			emitStepEvent(run, s, stepStatusSkipped, time.Time{}, nil, nil)
+			continue
+		}
+
+		log.Printf("===> %s...\n", s.description)
+
+		startedAt := time.Now()
+		before := copyValues(run.Values)
+
+		emitStepEvent(run, s, stepStatusStarted, startedAt, nil, nil)
+
+		if err := s.run(ctx); err != nil {
+			emitStepEvent(run, s, stepStatusFailed, startedAt, changedValues(before, run.Values), err)
+
+			if !rollbackOnFailure || ctx.Err() != nil {
+				log.Printf("===> Interrupted. Run `esnctl resume %s` to continue from this step.\n", run.ID)
+				return err
+			}
+
+			if rerr := rollbackSteps(ctx, run, steps[:i+1]); rerr != nil {
+				log.Printf("===> Rollback failed: %s\n", rerr)
+				log.Printf("===> Run `esnctl resume %s` to continue from the failed step.\n", run.ID)
+			}
+
+			return err
+		}
+
+		if err := run.Complete(s.name); err != nil {
+			return errors.Wrapf(err, "failed to save progress of %q", s.name)
+		}
+
+		emitStepEvent(run, s, stepStatusCompleted, startedAt, changedValues(before, run.Values), nil)
+	}
+
+	log.Println("===> Finished!")
+
+	return nil
+}
+
+// rollbackSteps undoes the given steps in reverse order
+// The last step is the failed one, which may have been partially applied
+func rollbackSteps(ctx context.Context, run *state.Run, steps []step) error {
+	for _, s := range steps {
+		if s.irreversible {
+			return errors.Errorf("%q cannot be rolled back", s.name)
+		}
+	}
+
+	log.Println("===> Rolling back...")
+
+	for i := len(steps) - 1; i >= 0; i-- {
+		s := steps[i]
+		startedAt := time.Now()
+
+		if s.rollback != nil {
+			log.Printf("===> Rolling back: %s...\n", s.description)
+			emitStepEvent(run, s, stepStatusRollingBack, startedAt, nil, nil)
+
+			if err := s.rollback(ctx); err != nil {
+				emitStepEvent(run, s, stepStatusFailed, startedAt, nil, err)
+				return errors.Wrapf(err, "failed to roll back %q", s.name)
+			}
+		}
+
+		if err := run.Revert(s.name); err != nil {
+			return errors.Wrapf(err, "failed to save progress of %q", s.name)
+		}
+
+		if s.rollback != nil {
+			emitStepEvent(run, s, stepStatusRolledBack, startedAt, nil, nil)
+		}
+	}
+
+	log.Println("===> Rolled back.")
+
+	return nil
+}
+
+func disableReallocationStep(client es.Client, prefix string) step {
+	return step{
+		name:        prefix + "disable-reallocation",
+		description: "Disabling shard reallocation",
+		run: func(ctx context.Context) error {
+			if err := client.DisableReallocation(ctx); err != nil {
+				return errors.Wrap(err, "failed to disable reallocation")
+			}
+
+			return nil
+		},
+		rollback: func(ctx context.Context) error {
+			if err := client.EnableReallocation(ctx); err != nil {
+				return errors.Wrap(err, "failed to enable reallocation")
+			}
+
+			return nil
+		},
+	}
+}
+
+func enableReallocationStep(client es.Client, prefix string) step {
+	return step{
+		name:        prefix + "enable-reallocation",
+		description: "Enabling shard reallocation",
+		run: func(ctx context.Context) error {
+			if err := client.EnableReallocation(ctx); err != nil {
+				return errors.Wrap(err, "failed to enable reallocation")
+			}
+
+			return nil
+		},
+	}
+}
+
+// newReallocationRestorer returns a function which enables shard reallocation again
+// if it has been disabled by the add steps with the given prefix but not enabled yet.
+// Reallocation must not be left disabled whatever happens after disabling it, so the restorer does not use the context
+// of the run, which may have been cancelled by signal.
+func newReallocationRestorer(run *state.Run, client es.Client, prefix string) func() {
+	return func() {
+		if !run.IsCompleted(prefix+"disable-reallocation") || run.IsCompleted(prefix+"enable-reallocation") {
+			return
+		}
+
+		log.Println("===> Enabling shard reallocation...")
+
+		if err := client.EnableReallocation(context.Background()); err != nil {
+			log.Printf("===> Failed to enable shard reallocation, please enable it manually: %s\n", err)
+			return
+		}
+
+		if err := run.Revert(prefix + "disable-reallocation"); err != nil {
+			log.Printf("===> Failed to save progress: %s\n", err)
+		}
+	}
+}
+
+func newRun(stateDir, command string, values map[string]string) (*state.Run, error) {
+	if stateDir == "" {
+		dir, err := state.DefaultDir()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to detect state directory")
+		}
+
+		stateDir = dir
+	}
+
+	run, err := state.New(stateDir, command, values)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create state file")
+	}
+
+	return run, nil
+}
+
+func joinValues(values []string) string {
+	return strings.Join(values, ",")
+}
+
+func splitValues(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+
+	return strings.Split(value, ",")
+}
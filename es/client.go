@@ -1,11 +1,31 @@
 package es
 
+import (
+	"context"
+
+	"github.com/dtan4/esnctl/es/types"
+)
+
 // Client represents innterface of Elasticsearch API client
 type Client interface {
-	DisableReallocation() error
-	EnableReallocation() error
-	ExcludeNodeFromAllocation(nodeName string) error
-	ListNodes() ([]string, error)
-	ListShardsOnNode(nodeName string) ([]string, error)
-	Shutdown(nodeName string) error
+	AddVotingConfigExclusions(ctx context.Context, nodeNames []string) error
+	AllocationSettings(ctx context.Context) (*types.AllocationSettings, error)
+	ClearVotingConfigExclusions(ctx context.Context) error
+	ClusterHealth(ctx context.Context) (*types.ClusterHealth, error)
+	DescribeNodes(ctx context.Context) ([]*types.Node, error)
+	DisableReallocation(ctx context.Context) error
+	DiskWatermarks(ctx context.Context) (*types.DiskWatermarks, error)
+	EnableReallocation(ctx context.Context) error
+	ExcludeNodesFromAllocation(ctx context.Context, attribute string, values []string) error
+	Flush(ctx context.Context) error
+	IncludeNodesInAllocation(ctx context.Context, attribute string, values []string) error
+	ListAllocations(ctx context.Context) ([]*types.Allocation, error)
+	ListNodes(ctx context.Context) ([]string, error)
+	ListNodeStats(ctx context.Context) ([]*types.NodeStats, error)
+	ListRecoveries(ctx context.Context) ([]*types.Recovery, error)
+	ListShards(ctx context.Context) ([]*types.Shard, error)
+	ListShardsOnNode(ctx context.Context, nodeName string) ([]string, error)
+	MasterNodeID(ctx context.Context) (string, error)
+	MasterQuorum(ctx context.Context) (*types.MasterQuorum, error)
+	Shutdown(ctx context.Context, nodeName string) error
 }
@@ -8,12 +8,29 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/dtan4/esnctl/es/opensearch"
 	"github.com/dtan4/esnctl/es/v1"
 	"github.com/dtan4/esnctl/es/v2"
 	"github.com/dtan4/esnctl/es/v5"
+	"github.com/dtan4/esnctl/es/v6"
+	"github.com/dtan4/esnctl/es/v7"
+	"github.com/dtan4/esnctl/es/v8"
 	"github.com/pkg/errors"
 )
 
+const (
+	// DistributionElasticsearch represents Elasticsearch
+	DistributionElasticsearch = "elasticsearch"
+	// DistributionOpenSearch represents OpenSearch
+	DistributionOpenSearch = "opensearch"
+)
+
+// Version represents distribution and version number of cluster
+type Version struct {
+	Distribution string
+	Number       string
+}
+
 // New creates and returns appropriate Elasticsearch client
 func New(clusterURL string, httpClient *http.Client) (Client, error) {
 	version, err := DetectVersion(clusterURL, httpClient)
@@ -21,7 +38,21 @@ func New(clusterURL string, httpClient *http.Client) (Client, error) {
 		return nil, errors.Wrap(err, "failed to detect Elasticsearch version")
 	}
 
-	digits := strings.Split(version, ".")
+	digits := strings.Split(version.Number, ".")
+
+	if version.Distribution == DistributionOpenSearch {
+		switch digits[0] {
+		case "1", "2":
+			client, err := opensearch.NewClient(clusterURL, httpClient)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to create OpenSearch API client")
+			}
+
+			return client, nil
+		}
+
+		return nil, errors.Errorf("OpenSearch version %q does not supported", version.Number)
+	}
 
 	switch digits[0] {
 	case "1":
@@ -44,17 +75,38 @@ func New(clusterURL string, httpClient *http.Client) (Client, error) {
 			return nil, errors.Wrap(err, "failed to create Elasticsearch API client")
 		}
 
+		return client, nil
+	case "6":
+		client, err := v6.NewClient(clusterURL, httpClient)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create Elasticsearch API client")
+		}
+
+		return client, nil
+	case "7":
+		client, err := v7.NewClient(clusterURL, httpClient)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create Elasticsearch API client")
+		}
+
+		return client, nil
+	case "8":
+		client, err := v8.NewClient(clusterURL, httpClient)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create Elasticsearch API client")
+		}
+
 		return client, nil
 	}
 
-	return nil, errors.Errorf("version %q does not supported", version)
+	return nil, errors.Errorf("version %q does not supported", version.Number)
 }
 
-// DetectVersion returns Elasticsearch version of the given endpoint
-func DetectVersion(clusterURL string, httpClient *http.Client) (string, error) {
+// DetectVersion returns distribution and version of the given endpoint
+func DetectVersion(clusterURL string, httpClient *http.Client) (*Version, error) {
 	u, err := url.Parse(clusterURL)
 	if err != nil {
-		return "", errors.Wrap(err, "cluster URL is invalid")
+		return nil, errors.Wrap(err, "cluster URL is invalid")
 	}
 
 	var endpoint string
@@ -67,48 +119,61 @@ func DetectVersion(clusterURL string, httpClient *http.Client) (string, error) {
 
 	req, err := http.NewRequest("GET", endpoint, nil)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to make http request")
+		return nil, errors.Wrap(err, "failed to make http request")
 	}
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to access to root API")
+		return nil, errors.Wrap(err, "failed to access to root API")
 	}
 	defer resp.Body.Close()
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to read response body")
+		return nil, errors.Wrap(err, "failed to read response body")
 	}
 
 	var bodyStruct map[string]interface{}
 
 	if err := json.Unmarshal(body, &bodyStruct); err != nil {
-		return "", errors.Wrap(err, "invalid response body")
+		return nil, errors.Wrap(err, "invalid response body")
 	}
 
 	if _, ok := bodyStruct["OK"]; ok {
-		return "1.0.0", nil
+		return &Version{
+			Distribution: DistributionElasticsearch,
+			Number:       "1.0.0",
+		}, nil
 	}
 
 	v, ok := bodyStruct["version"]
 	if !ok {
-		return "", errors.New("version field not found")
+		return nil, errors.New("version field not found")
 	}
 
 	version, ok := v.(map[string]interface{})
 	if !ok {
-		return "", errors.New("invalid version field")
+		return nil, errors.New("invalid version field")
 	}
 
 	if _, ok := version["number"]; !ok {
-		return "", errors.New("version number field not found")
+		return nil, errors.New("version number field not found")
 	}
 
 	vn, ok := version["number"].(string)
 	if !ok {
-		return "", errors.New("invalid version number field")
+		return nil, errors.New("invalid version number field")
+	}
+
+	// Only OpenSearch has version.distribution field
+	distribution := DistributionElasticsearch
+
+	if d, ok := version["distribution"].(string); ok && d != "" {
+		distribution = d
 	}
 
-	return vn, nil
+	return &Version{
+		Distribution: distribution,
+		Number:       vn,
+	}, nil
 }
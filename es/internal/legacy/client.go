@@ -0,0 +1,1133 @@
+// Package legacy implements the part of Elasticsearch API client over REST API,
+// shared by Elasticsearch 1.x, 2.x and 5.x
+package legacy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/pkg/errors"
+)
+
+// Config represents the differences between versions
+type Config struct {
+	// SyncedFlush is whether Flush performs synced flush rather than normal flush
+	SyncedFlush bool
+	// RolesFromAttributes is whether node roles are derived from master and data attributes,
+	// because Elasticsearch before 5.x does not report node roles
+	RolesFromAttributes bool
+	// ShutdownAPI is whether the cluster has nodes shutdown API, which was removed in Elasticsearch 2.x
+	ShutdownAPI bool
+}
+
+// Client represents Elasticsearch API client
+type Client struct {
+	clusterEndpoint string
+	httpClient      *http.Client
+	config          Config
+}
+
+// NewClient creates new Client object
+func NewClient(clusterURL string, httpClient *http.Client, config Config) (*Client, error) {
+	u, err := url.Parse(clusterURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse cluster URL")
+	}
+
+	var clusterEndpoint string
+
+	if u.User == nil {
+		clusterEndpoint = fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	} else {
+		password, _ := u.User.Password()
+		clusterEndpoint = fmt.Sprintf("%s://%s:%s@%s", u.Scheme, u.User.Username(), password, u.Host)
+	}
+
+	return &Client{
+		clusterEndpoint: clusterEndpoint,
+		httpClient:      httpClient,
+		config:          config,
+	}, nil
+}
+
+// ClusterEndpoint returns the endpoint of cluster, with credentials if given
+func (c *Client) ClusterEndpoint() string {
+	return c.clusterEndpoint
+}
+
+// DisableReallocation disables shard reallocation
+// Modifies cluster.routing.allocation.enable to "none"
+// https://www.elastic.co/guide/en/elasticsearch/reference/1.5/cluster-update-settings.html
+func (c *Client) DisableReallocation(ctx context.Context) error {
+	endpoint := c.clusterEndpoint + "/_cluster/settings"
+
+	reqBody, err := settingsBody("cluster.routing.allocation.enable", "none")
+	if err != nil {
+		return errors.Wrap(err, "failed to make DisableReallocation request")
+	}
+
+	req, err := http.NewRequest("PUT", endpoint, strings.NewReader(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to make DisableReallocation request")
+	}
+	defer req.Body.Close()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute DisableReallocation request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute DisableReallocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// EnableReallocation enables shard reallocation
+// Modifies cluster.routing.allocation.enable to "all"
+// https://www.elastic.co/guide/en/elasticsearch/reference/1.5/cluster-update-settings.html
+func (c *Client) EnableReallocation(ctx context.Context) error {
+	endpoint := c.clusterEndpoint + "/_cluster/settings"
+
+	reqBody, err := settingsBody("cluster.routing.allocation.enable", "all")
+	if err != nil {
+		return errors.Wrap(err, "failed to make EnableReallocation request")
+	}
+
+	req, err := http.NewRequest("PUT", endpoint, strings.NewReader(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to make EnableReallocation request")
+	}
+	defer req.Body.Close()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute EnableReallocation request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute EnableReallocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// ExcludeNodesFromAllocation excludes the nodes matching the given attribute values from shard allocation group
+// attribute is one of "_name", "_ip", "_id" or custom node attribute
+// Nodes already excluded by other operations are kept excluded
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/allocation-filtering.html
+func (c *Client) ExcludeNodesFromAllocation(ctx context.Context, attribute string, values []string) error {
+	excludedValues, err := c.listExcludedValues(ctx, attribute)
+	if err != nil {
+		return errors.Wrap(err, "failed to list nodes excluded from allocation group")
+	}
+
+	for _, value := range values {
+		excluded := false
+
+		for _, excludedValue := range excludedValues {
+			if excludedValue == value {
+				excluded = true
+				break
+			}
+		}
+
+		if !excluded {
+			excludedValues = append(excludedValues, value)
+		}
+	}
+
+	endpoint := c.clusterEndpoint + "/_cluster/settings"
+	reqBody, err := settingsBody("cluster.routing.allocation.exclude."+attribute, strings.Join(excludedValues, ","))
+	if err != nil {
+		return errors.Wrap(err, "failed to make ExcludeNodesFromAllocation request")
+	}
+
+	req, err := http.NewRequest("PUT", endpoint, strings.NewReader(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to make ExcludeNodesFromAllocation request")
+	}
+	defer req.Body.Close()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute ExcludeNodesFromAllocation request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute ExcludeNodesFromAllocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// IncludeNodesInAllocation removes the given attribute values from the list of nodes excluded from shard allocation group
+// attribute is one of "_name", "_ip", "_id" or custom node attribute
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/allocation-filtering.html
+func (c *Client) IncludeNodesInAllocation(ctx context.Context, attribute string, values []string) error {
+	excludedValues, err := c.listExcludedValues(ctx, attribute)
+	if err != nil {
+		return errors.Wrap(err, "failed to list nodes excluded from allocation group")
+	}
+
+	remainingValues := []string{}
+
+	for _, excludedValue := range excludedValues {
+		included := false
+
+		for _, value := range values {
+			if excludedValue == value {
+				included = true
+				break
+			}
+		}
+
+		if !included {
+			remainingValues = append(remainingValues, excludedValue)
+		}
+	}
+
+	endpoint := c.clusterEndpoint + "/_cluster/settings"
+	reqBody, err := settingsBody("cluster.routing.allocation.exclude."+attribute, strings.Join(remainingValues, ","))
+	if err != nil {
+		return errors.Wrap(err, "failed to make IncludeNodesInAllocation request")
+	}
+
+	req, err := http.NewRequest("PUT", endpoint, strings.NewReader(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to make IncludeNodesInAllocation request")
+	}
+	defer req.Body.Close()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute IncludeNodesInAllocation request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute IncludeNodesInAllocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// AddVotingConfigExclusions does nothing, because Elasticsearch 5.x or earlier elects master by discovery.zen.minimum_master_nodes
+// instead of voting configuration
+func (c *Client) AddVotingConfigExclusions(ctx context.Context, nodeNames []string) error {
+	return nil
+}
+
+// ClearVotingConfigExclusions does nothing, because Elasticsearch 5.x or earlier does not have voting configuration
+func (c *Client) ClearVotingConfigExclusions(ctx context.Context) error {
+	return nil
+}
+
+// AllocationSettings returns cluster-wide shard allocation settings
+// Transient setting takes precedence over persistent one
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/cluster-update-settings.html
+func (c *Client) AllocationSettings(ctx context.Context) (*types.AllocationSettings, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/settings?flat_settings=true"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make cluster-settings request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute cluster-settings request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to execute cluster-settings request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var settings struct {
+		Persistent map[string]interface{} `json:"persistent"`
+		Transient  map[string]interface{} `json:"transient"`
+	}
+
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, errors.Wrap(err, "invalid response body")
+	}
+
+	values := map[string]string{}
+
+	for _, s := range []map[string]interface{}{settings.Persistent, settings.Transient} {
+		for key, value := range s {
+			if v, ok := value.(string); ok {
+				values[key] = v
+			}
+		}
+	}
+
+	allocation := &types.AllocationSettings{
+		Enable:  "all",
+		Exclude: map[string][]string{},
+	}
+
+	for key, value := range values {
+		switch {
+		case key == "cluster.routing.allocation.enable":
+			if value != "" {
+				allocation.Enable = value
+			}
+		case strings.HasPrefix(key, "cluster.routing.allocation.exclude."):
+			excluded := []string{}
+
+			for _, v := range strings.Split(value, ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					excluded = append(excluded, v)
+				}
+			}
+
+			if len(excluded) > 0 {
+				allocation.Exclude[strings.TrimPrefix(key, "cluster.routing.allocation.exclude.")] = excluded
+			}
+		}
+	}
+
+	return allocation, nil
+}
+
+// ClusterHealth returns health status of the cluster
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/cluster-health.html
+func (c *Client) ClusterHealth(ctx context.Context) (*types.ClusterHealth, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/health"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make ClusterHealth request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute ClusterHealth request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to execute ClusterHealth request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var health types.ClusterHealth
+
+	if err := json.Unmarshal(body, &health); err != nil {
+		return nil, errors.Wrap(err, "invalid response body")
+	}
+
+	return &health, nil
+}
+
+// DescribeNodes returns the list of nodes with their ID, IP and attributes
+func (c *Client) DescribeNodes(ctx context.Context) ([]*types.Node, error) {
+	endpoint := c.clusterEndpoint + "/_nodes"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.Node{}, errors.Wrap(err, "failed to make NodesInfo request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.Node{}, errors.Wrap(err, "failed to execute NodesInfo request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.Node{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.Node{}, errors.Errorf("failed to execute NodesInfo request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var nodesInfo struct {
+		Nodes map[string]struct {
+			Name       string            `json:"name"`
+			IP         string            `json:"ip"`
+			Attributes map[string]string `json:"attributes"`
+			Roles      []string          `json:"roles"`
+			JVM        struct {
+				StartTimeInMillis int64 `json:"start_time_in_millis"`
+			} `json:"jvm"`
+		} `json:"nodes"`
+	}
+
+	if err := json.Unmarshal(body, &nodesInfo); err != nil {
+		return []*types.Node{}, errors.Wrap(err, "invalid response body")
+	}
+
+	nodes := []*types.Node{}
+
+	for id, node := range nodesInfo.Nodes {
+		attributes := node.Attributes
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+
+		roles := node.Roles
+		if c.config.RolesFromAttributes {
+			roles = rolesFromAttributes(attributes)
+		} else if roles == nil {
+			roles = []string{}
+		}
+
+		nodes = append(nodes, &types.Node{
+			ID:             id,
+			Name:           node.Name,
+			IP:             node.IP,
+			Attributes:     attributes,
+			StartTime:      node.JVM.StartTimeInMillis,
+			MasterEligible: isMasterEligible(roles),
+			Roles:          roles,
+		})
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	return nodes, nil
+}
+
+// DiskWatermarks returns disk-based shard allocation settings
+// Settings in elasticsearch.yml are not visible via API, so settings not configured by cluster update settings API are
+// filled with default values
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/disk-allocator.html
+func (c *Client) DiskWatermarks(ctx context.Context) (*types.DiskWatermarks, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/settings?flat_settings=true"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make cluster-settings request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute cluster-settings request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to execute cluster-settings request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var settings struct {
+		Persistent map[string]interface{} `json:"persistent"`
+		Transient  map[string]interface{} `json:"transient"`
+	}
+
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, errors.Wrap(err, "invalid response body")
+	}
+
+	setting := func(key, defaultValue string) string {
+		// Transient setting takes precedence over persistent one
+		for _, values := range []map[string]interface{}{settings.Transient, settings.Persistent} {
+			if value, ok := values[key].(string); ok && value != "" {
+				return value
+			}
+		}
+
+		return defaultValue
+	}
+
+	return &types.DiskWatermarks{
+		ThresholdEnabled: setting("cluster.routing.allocation.disk.threshold_enabled", "true") == "true",
+		Low:              setting("cluster.routing.allocation.disk.watermark.low", types.DefaultDiskWatermarkLow),
+		High:             setting("cluster.routing.allocation.disk.watermark.high", types.DefaultDiskWatermarkHigh),
+	}, nil
+}
+
+// Flush flushes all indices, with synced flush if configured
+// Some shards may fail to be synced while indexing, which is not an error
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/indices-synced-flush.html
+func (c *Client) Flush(ctx context.Context) error {
+	endpoint := c.clusterEndpoint + "/_flush"
+
+	if c.config.SyncedFlush {
+		endpoint += "/synced"
+	}
+
+	req, err := http.NewRequest("POST", endpoint, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to make Flush request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute Flush request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && !(c.config.SyncedFlush && resp.StatusCode == http.StatusConflict) {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute Flush request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// ListAllocations returns the number of shards and disk usage of each node
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/cat-allocation.html
+func (c *Client) ListAllocations(ctx context.Context) ([]*types.Allocation, error) {
+	endpoint := c.clusterEndpoint + "/_cat/allocation?bytes=b&h=shards,disk.used,disk.avail,disk.total,node"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.Allocation{}, errors.Wrap(err, "failed to make cat-allocation request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.Allocation{}, errors.Wrap(err, "failed to execute cat-allocation request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.Allocation{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.Allocation{}, errors.Errorf("failed to execute cat-allocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	allocations := []*types.Allocation{}
+
+	for _, line := range strings.Split(string(body), "\n") {
+		fields := strings.Fields(line)
+
+		// Line of unassigned shards has only shards and "UNASSIGNED" columns
+		if len(fields) < 5 {
+			continue
+		}
+
+		shards, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return []*types.Allocation{}, errors.Wrapf(err, "invalid shards column %q", fields[0])
+		}
+
+		diskBytes := []int64{}
+
+		for _, field := range fields[1:4] {
+			bytes, err := strconv.ParseInt(field, 10, 64)
+			if err != nil {
+				return []*types.Allocation{}, errors.Wrapf(err, "invalid disk column %q", field)
+			}
+
+			diskBytes = append(diskBytes, bytes)
+		}
+
+		allocations = append(allocations, &types.Allocation{
+			NodeName:           strings.Join(fields[4:], " "),
+			Shards:             shards,
+			DiskUsedBytes:      diskBytes[0],
+			DiskAvailableBytes: diskBytes[1],
+			DiskTotalBytes:     diskBytes[2],
+		})
+	}
+
+	return allocations, nil
+}
+
+// MasterQuorum returns discovery.zen.minimum_master_nodes
+// Setting updated by cluster update settings API takes precedence over the one in elasticsearch.yml of the elected master
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/modules-discovery-zen.html
+func (c *Client) MasterQuorum(ctx context.Context) (*types.MasterQuorum, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/settings?flat_settings=true"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make cluster-settings request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute cluster-settings request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to execute cluster-settings request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var settings struct {
+		Persistent map[string]interface{} `json:"persistent"`
+		Transient  map[string]interface{} `json:"transient"`
+	}
+
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, errors.Wrap(err, "invalid response body")
+	}
+
+	key := "discovery.zen.minimum_master_nodes"
+
+	// Transient setting takes precedence over persistent one
+	value, ok := settings.Transient[key]
+	if !ok {
+		value, ok = settings.Persistent[key]
+	}
+
+	if !ok {
+		value, ok, err = c.masterNodeSetting(ctx, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve settings of master node")
+		}
+	}
+
+	quorum := &types.MasterQuorum{
+		VotingConfig:           []string{},
+		VotingConfigExclusions: []string{},
+	}
+
+	if !ok {
+		return quorum, nil
+	}
+
+	// Setting value is usually string, but may be number if it was updated with number
+	n, err := strconv.Atoi(fmt.Sprint(value))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s %v", key, value)
+	}
+
+	quorum.MinimumMasterNodes = n
+
+	return quorum, nil
+}
+
+// MasterNodeID returns the ID of the elected master node
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/cluster-state.html
+func (c *Client) MasterNodeID(ctx context.Context) (string, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/state/master_node"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to make ClusterState request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to execute ClusterState request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to execute ClusterState request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var clusterState struct {
+		MasterNode string `json:"master_node"`
+	}
+
+	if err := json.Unmarshal(body, &clusterState); err != nil {
+		return "", errors.Wrap(err, "invalid response body")
+	}
+
+	return clusterState.MasterNode, nil
+}
+
+// ListNodeStats returns JVM heap usage of each node
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/cluster-nodes-stats.html
+func (c *Client) ListNodeStats(ctx context.Context) ([]*types.NodeStats, error) {
+	endpoint := c.clusterEndpoint + "/_nodes/stats/jvm"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.NodeStats{}, errors.Wrap(err, "failed to make NodesStats request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.NodeStats{}, errors.Wrap(err, "failed to execute NodesStats request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.NodeStats{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.NodeStats{}, errors.Errorf("failed to execute NodesStats request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var nodesStats struct {
+		Nodes map[string]struct {
+			Name string `json:"name"`
+			JVM  struct {
+				Mem struct {
+					HeapUsedInBytes int64 `json:"heap_used_in_bytes"`
+					HeapUsedPercent int   `json:"heap_used_percent"`
+					HeapMaxInBytes  int64 `json:"heap_max_in_bytes"`
+				} `json:"mem"`
+			} `json:"jvm"`
+		} `json:"nodes"`
+	}
+
+	if err := json.Unmarshal(body, &nodesStats); err != nil {
+		return []*types.NodeStats{}, errors.Wrap(err, "invalid response body")
+	}
+
+	stats := []*types.NodeStats{}
+
+	for _, node := range nodesStats.Nodes {
+		stats = append(stats, &types.NodeStats{
+			NodeName:        node.Name,
+			HeapUsedBytes:   node.JVM.Mem.HeapUsedInBytes,
+			HeapMaxBytes:    node.JVM.Mem.HeapMaxInBytes,
+			HeapUsedPercent: node.JVM.Mem.HeapUsedPercent,
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].NodeName < stats[j].NodeName
+	})
+
+	return stats, nil
+}
+
+// ListRecoveries returns shard recoveries, including relocations, with the number of bytes recovered
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/cat-recovery.html
+func (c *Client) ListRecoveries(ctx context.Context) ([]*types.Recovery, error) {
+	endpoint := c.clusterEndpoint + "/_cat/recovery?bytes=b&h=index,shard,stage,bytes,bytes_percent"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.Recovery{}, errors.Wrap(err, "failed to make cat-recovery request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.Recovery{}, errors.Wrap(err, "failed to execute cat-recovery request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.Recovery{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.Recovery{}, errors.Errorf("failed to execute cat-recovery request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	recoveries := []*types.Recovery{}
+
+	for _, line := range strings.Split(string(body), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 5 {
+			continue
+		}
+
+		recovery, err := parseRecovery(fields[0], fields[1], fields[2], fields[3], fields[4])
+		if err != nil {
+			return []*types.Recovery{}, err
+		}
+
+		recoveries = append(recoveries, recovery)
+	}
+
+	return recoveries, nil
+}
+
+// ListShards returns all shards in the cluster with their store size
+// https://www.elastic.co/guide/en/elasticsearch/reference/5.6/cat-shards.html
+func (c *Client) ListShards(ctx context.Context) ([]*types.Shard, error) {
+	endpoint := c.clusterEndpoint + "/_cat/shards?bytes=b&h=index,shard,prirep,state,store,node"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.Shard{}, errors.Wrap(err, "failed to make cat-shards request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.Shard{}, errors.Wrap(err, "failed to execute cat-shards request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.Shard{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.Shard{}, errors.Errorf("failed to execute cat-shards request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	shards := []*types.Shard{}
+
+	for _, line := range strings.Split(string(body), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 {
+			continue
+		}
+
+		// Store and node columns are empty for unassigned shards, so store is told from node by whether it is a number
+		store, rest := "", fields[4:]
+
+		if len(rest) > 0 {
+			if _, err := strconv.ParseInt(rest[0], 10, 64); err == nil {
+				store, rest = rest[0], rest[1:]
+			}
+		}
+
+		shard, err := parseShard(fields[0], fields[1], fields[2], fields[3], store, strings.Join(rest, " "))
+		if err != nil {
+			return []*types.Shard{}, err
+		}
+
+		shards = append(shards, shard)
+	}
+
+	return shards, nil
+}
+
+// ListShardsOnNode returns the list of shards on the given node
+// Shards relocating from the given node are also included
+func (c *Client) ListShardsOnNode(ctx context.Context, nodeName string) ([]string, error) {
+	endpoint := c.clusterEndpoint + "/_cat/shards?h=index,shard,prirep,state,node"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to make cat-shards request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to execute cat-shards request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []string{}, errors.Errorf("failed to execute cat-shards request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	shardsOnNode := []string{}
+
+	for _, line := range strings.Split(string(body), "\n") {
+		fields := strings.Fields(line)
+		// Node column is empty for unassigned shards
+		if len(fields) < 5 {
+			continue
+		}
+
+		// Node name may contain spaces, and node column of relocating shard looks like
+		// "node1 -> 10.0.1.24 Ab1cD2eFG3hIJ4kLMnOpQr node2"
+		node := strings.Join(fields[4:], " ")
+
+		if node == nodeName || strings.HasPrefix(node, nodeName+" -> ") {
+			shardsOnNode = append(shardsOnNode, strings.Join(fields, " "))
+		}
+	}
+
+	return shardsOnNode, nil
+}
+
+// Shutdown shutdowns the given node
+// Nothing is done if the cluster does not have shutdown API
+// https://www.elastic.co/guide/en/elasticsearch/reference/1.5/cluster-nodes-shutdown.html
+func (c *Client) Shutdown(ctx context.Context, nodeName string) error {
+	if !c.config.ShutdownAPI {
+		return nil
+	}
+
+	endpoint := c.clusterEndpoint + "/_cluster/nodes/" + nodeName + "/_shutdown"
+
+	req, err := http.NewRequest("POST", endpoint, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to make Shutdown request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute Shutdown request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute Shutdown request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// masterNodeSetting returns the given setting of the elected master node, which is configured in its elasticsearch.yml
+func (c *Client) masterNodeSetting(ctx context.Context, key string) (interface{}, bool, error) {
+	endpoint := c.clusterEndpoint + "/_nodes/_master/settings?flat_settings=true"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to make NodesInfo request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to execute NodesInfo request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, errors.Errorf("failed to execute NodesInfo request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var nodesInfo struct {
+		Nodes map[string]struct {
+			Settings map[string]interface{} `json:"settings"`
+		} `json:"nodes"`
+	}
+
+	if err := json.Unmarshal(body, &nodesInfo); err != nil {
+		return nil, false, errors.Wrap(err, "invalid response body")
+	}
+
+	// Only the elected master node is returned
+	for _, node := range nodesInfo.Nodes {
+		if value, ok := node.Settings[key]; ok {
+			return value, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// listExcludedValues returns the list of attribute values currently excluded from shard allocation group
+func (c *Client) listExcludedValues(ctx context.Context, attribute string) ([]string, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/settings?flat_settings=true"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to make cluster-settings request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to execute cluster-settings request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []string{}, errors.Errorf("failed to execute cluster-settings request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var settings struct {
+		Persistent map[string]interface{} `json:"persistent"`
+		Transient  map[string]interface{} `json:"transient"`
+	}
+
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return []string{}, errors.Wrap(err, "invalid response body")
+	}
+
+	key := "cluster.routing.allocation.exclude." + attribute
+	values := []string{}
+
+	// Transient setting takes precedence over persistent one
+	value, ok := settings.Transient[key].(string)
+	if !ok {
+		value, ok = settings.Persistent[key].(string)
+	}
+
+	if !ok || value == "" {
+		return values, nil
+	}
+
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values, nil
+}
+
+// parseRecovery builds Recovery from the columns of cat recovery API
+// bytes_percent column looks like "42.5%"
+func parseRecovery(index, shard, stage, bytes, bytesPercent string) (*types.Recovery, error) {
+	n, err := strconv.Atoi(shard)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid shard column %q", shard)
+	}
+
+	b, err := strconv.ParseInt(bytes, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid bytes column %q", bytes)
+	}
+
+	percent, err := strconv.ParseFloat(strings.TrimSuffix(bytesPercent, "%"), 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid bytes_percent column %q", bytesPercent)
+	}
+
+	return &types.Recovery{
+		Index:          index,
+		Shard:          n,
+		Stage:          stage,
+		Bytes:          b,
+		BytesRecovered: int64(float64(b) * percent / 100),
+	}, nil
+}
+
+// parseShard builds Shard from the columns of cat shards API
+func parseShard(index, shard, prirep, state, store, node string) (*types.Shard, error) {
+	n, err := strconv.Atoi(shard)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid shard column %q", shard)
+	}
+
+	s := &types.Shard{
+		Index:   index,
+		Shard:   n,
+		Primary: prirep == "p",
+		State:   state,
+	}
+
+	if store != "" {
+		bytes, err := strconv.ParseInt(store, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid store column %q", store)
+		}
+
+		s.StoreBytes = bytes
+	}
+
+	// node column of relocating shard looks like "node1 -> 10.0.1.24 Ab1cD2eFG3hIJ4kLMnOpQr node2"
+	if i := strings.Index(node, " -> "); i >= 0 {
+		if target := strings.Fields(node[i+len(" -> "):]); len(target) > 2 {
+			s.RelocatingNodeName = strings.Join(target[2:], " ")
+		}
+
+		node = node[:i]
+	}
+
+	s.NodeName = node
+
+	return s, nil
+}
+
+// isMasterEligible returns whether the node having the given roles can be elected as master
+func isMasterEligible(roles []string) bool {
+	for _, role := range roles {
+		if role == "master" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// settingsBody returns the request body of cluster update settings API updating the given transient setting
+func settingsBody(key, value string) (string, error) {
+	body, err := json.Marshal(map[string]map[string]string{
+		"transient": map[string]string{
+			key: value,
+		},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode settings")
+	}
+
+	return string(body), nil
+}
+
+// rolesFromAttributes derives roles of the node from its master and data attributes,
+// because Elasticsearch before 5.x does not report node roles
+func rolesFromAttributes(attributes map[string]string) []string {
+	roles := []string{}
+
+	if attributes["master"] != "false" {
+		roles = append(roles, "master")
+	}
+
+	if attributes["data"] != "false" {
+		roles = append(roles, "data")
+	}
+
+	return roles
+}
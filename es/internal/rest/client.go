@@ -0,0 +1,1291 @@
+// Package rest implements Elasticsearch API client over REST API,
+// shared by Elasticsearch 6.x or later and OpenSearch
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dtan4/esnctl/es/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ScopeTransient represents transient cluster settings, which are reset on full cluster restart
+	ScopeTransient = "transient"
+	// ScopePersistent represents persistent cluster settings
+	ScopePersistent = "persistent"
+)
+
+const (
+	// VotingExclusionsNone represents cluster electing master by discovery.zen.minimum_master_nodes
+	// instead of voting configuration
+	VotingExclusionsNone = iota
+	// VotingExclusionsByPath represents voting configuration exclusions API taking node names in path
+	VotingExclusionsByPath
+	// VotingExclusionsByQuery represents voting configuration exclusions API taking node_names parameter
+	VotingExclusionsByQuery
+)
+
+// Config represents the differences between versions and distributions
+type Config struct {
+	// SettingsScope is the scope cluster settings are updated in, ScopeTransient or ScopePersistent
+	SettingsScope string
+	// VotingExclusions is how master-eligible nodes are excluded from voting configuration
+	VotingExclusions int
+	// SyncedFlush is whether Flush performs synced flush rather than normal flush
+	SyncedFlush bool
+	// IncludeDefaults is whether cluster settings API returns default values with include_defaults parameter
+	IncludeDefaults bool
+	// MasterRoles are the names of the role making node master-eligible
+	MasterRoles []string
+}
+
+// Client represents Elasticsearch API client
+type Client struct {
+	clusterEndpoint string
+	httpClient      *http.Client
+	config          Config
+}
+
+// NewClient creates new Client object
+func NewClient(clusterURL string, httpClient *http.Client, config Config) (*Client, error) {
+	u, err := url.Parse(clusterURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse cluster URL")
+	}
+
+	var clusterEndpoint string
+
+	if u.User == nil {
+		clusterEndpoint = fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	} else {
+		password, _ := u.User.Password()
+		clusterEndpoint = fmt.Sprintf("%s://%s:%s@%s", u.Scheme, u.User.Username(), password, u.Host)
+	}
+
+	return &Client{
+		clusterEndpoint: clusterEndpoint,
+		httpClient:      httpClient,
+		config:          config,
+	}, nil
+}
+
+// DisableReallocation disables shard reallocation
+// Modifies cluster.routing.allocation.enable to "none"
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-update-settings.html
+func (c *Client) DisableReallocation(ctx context.Context) error {
+	endpoint := c.clusterEndpoint + "/_cluster/settings"
+
+	reqBody, err := c.settingsBody("cluster.routing.allocation.enable", "none")
+	if err != nil {
+		return errors.Wrap(err, "failed to make DisableReallocation request")
+	}
+
+	req, err := newJSONRequest("PUT", endpoint, strings.NewReader(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to make DisableReallocation request")
+	}
+	defer req.Body.Close()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute DisableReallocation request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute DisableReallocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// EnableReallocation enables shard reallocation
+// Modifies cluster.routing.allocation.enable to "all"
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-update-settings.html
+func (c *Client) EnableReallocation(ctx context.Context) error {
+	endpoint := c.clusterEndpoint + "/_cluster/settings"
+
+	reqBody, err := c.settingsBody("cluster.routing.allocation.enable", "all")
+	if err != nil {
+		return errors.Wrap(err, "failed to make EnableReallocation request")
+	}
+
+	req, err := newJSONRequest("PUT", endpoint, strings.NewReader(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to make EnableReallocation request")
+	}
+	defer req.Body.Close()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute EnableReallocation request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute EnableReallocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// ExcludeNodesFromAllocation excludes the nodes matching the given attribute values from shard allocation group
+// attribute is one of "_name", "_ip", "_id" or custom node attribute
+// Nodes already excluded by other operations are kept excluded
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/allocation-filtering.html
+func (c *Client) ExcludeNodesFromAllocation(ctx context.Context, attribute string, values []string) error {
+	excludedValues, err := c.listExcludedValues(ctx, attribute)
+	if err != nil {
+		return errors.Wrap(err, "failed to list nodes excluded from allocation group")
+	}
+
+	for _, value := range values {
+		excluded := false
+
+		for _, excludedValue := range excludedValues {
+			if excludedValue == value {
+				excluded = true
+				break
+			}
+		}
+
+		if !excluded {
+			excludedValues = append(excludedValues, value)
+		}
+	}
+
+	endpoint := c.clusterEndpoint + "/_cluster/settings"
+	reqBody, err := c.settingsBody("cluster.routing.allocation.exclude."+attribute, strings.Join(excludedValues, ","))
+	if err != nil {
+		return errors.Wrap(err, "failed to make ExcludeNodesFromAllocation request")
+	}
+
+	req, err := newJSONRequest("PUT", endpoint, strings.NewReader(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to make ExcludeNodesFromAllocation request")
+	}
+	defer req.Body.Close()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute ExcludeNodesFromAllocation request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute ExcludeNodesFromAllocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// IncludeNodesInAllocation removes the given attribute values from the list of nodes excluded from shard allocation group
+// attribute is one of "_name", "_ip", "_id" or custom node attribute
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/allocation-filtering.html
+func (c *Client) IncludeNodesInAllocation(ctx context.Context, attribute string, values []string) error {
+	excludedValues, err := c.listExcludedValues(ctx, attribute)
+	if err != nil {
+		return errors.Wrap(err, "failed to list nodes excluded from allocation group")
+	}
+
+	remainingValues := []string{}
+
+	for _, excludedValue := range excludedValues {
+		included := false
+
+		for _, value := range values {
+			if excludedValue == value {
+				included = true
+				break
+			}
+		}
+
+		if !included {
+			remainingValues = append(remainingValues, excludedValue)
+		}
+	}
+
+	endpoint := c.clusterEndpoint + "/_cluster/settings"
+	reqBody, err := c.settingsBody("cluster.routing.allocation.exclude."+attribute, strings.Join(remainingValues, ","))
+	if err != nil {
+		return errors.Wrap(err, "failed to make IncludeNodesInAllocation request")
+	}
+
+	req, err := newJSONRequest("PUT", endpoint, strings.NewReader(reqBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to make IncludeNodesInAllocation request")
+	}
+	defer req.Body.Close()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute IncludeNodesInAllocation request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute IncludeNodesInAllocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// AddVotingConfigExclusions excludes the given master-eligible nodes from the voting configuration
+// Nothing is done if the cluster elects master by discovery.zen.minimum_master_nodes
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/voting-config-exclusions.html
+func (c *Client) AddVotingConfigExclusions(ctx context.Context, nodeNames []string) error {
+	var endpoint string
+
+	switch c.config.VotingExclusions {
+	case VotingExclusionsByPath:
+		endpoint = c.clusterEndpoint + "/_cluster/voting_config_exclusions/" + strings.Join(nodeNames, ",")
+	case VotingExclusionsByQuery:
+		endpoint = c.clusterEndpoint + "/_cluster/voting_config_exclusions?node_names=" + url.QueryEscape(strings.Join(nodeNames, ","))
+	default:
+		return nil
+	}
+
+	req, err := http.NewRequest("POST", endpoint, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to make AddVotingConfigExclusions request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute AddVotingConfigExclusions request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute AddVotingConfigExclusions request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// ClearVotingConfigExclusions clears all voting configuration exclusions
+// Excluded nodes are not waited for to leave the cluster, because callers check it by themselves
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/voting-config-exclusions.html
+func (c *Client) ClearVotingConfigExclusions(ctx context.Context) error {
+	if c.config.VotingExclusions == VotingExclusionsNone {
+		return nil
+	}
+
+	endpoint := c.clusterEndpoint + "/_cluster/voting_config_exclusions?wait_for_removal=false"
+
+	req, err := http.NewRequest("DELETE", endpoint, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to make ClearVotingConfigExclusions request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute ClearVotingConfigExclusions request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute ClearVotingConfigExclusions request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// AllocationSettings returns cluster-wide shard allocation settings
+// Transient setting takes precedence over persistent one
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-update-settings.html
+func (c *Client) AllocationSettings(ctx context.Context) (*types.AllocationSettings, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/settings?flat_settings=true"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make cluster-settings request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute cluster-settings request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to execute cluster-settings request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var settings struct {
+		Persistent map[string]interface{} `json:"persistent"`
+		Transient  map[string]interface{} `json:"transient"`
+	}
+
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, errors.Wrap(err, "invalid response body")
+	}
+
+	values := map[string]string{}
+
+	for _, s := range []map[string]interface{}{settings.Persistent, settings.Transient} {
+		for key, value := range s {
+			if v, ok := value.(string); ok {
+				values[key] = v
+			}
+		}
+	}
+
+	allocation := &types.AllocationSettings{
+		Enable:  "all",
+		Exclude: map[string][]string{},
+	}
+
+	for key, value := range values {
+		switch {
+		case key == "cluster.routing.allocation.enable":
+			if value != "" {
+				allocation.Enable = value
+			}
+		case strings.HasPrefix(key, "cluster.routing.allocation.exclude."):
+			excluded := []string{}
+
+			for _, v := range strings.Split(value, ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					excluded = append(excluded, v)
+				}
+			}
+
+			if len(excluded) > 0 {
+				allocation.Exclude[strings.TrimPrefix(key, "cluster.routing.allocation.exclude.")] = excluded
+			}
+		}
+	}
+
+	return allocation, nil
+}
+
+// ClusterHealth returns health status of the cluster
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-health.html
+func (c *Client) ClusterHealth(ctx context.Context) (*types.ClusterHealth, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/health"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make ClusterHealth request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute ClusterHealth request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to execute ClusterHealth request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var health types.ClusterHealth
+
+	if err := json.Unmarshal(body, &health); err != nil {
+		return nil, errors.Wrap(err, "invalid response body")
+	}
+
+	return &health, nil
+}
+
+// DescribeNodes returns the list of nodes with their ID, IP and attributes
+func (c *Client) DescribeNodes(ctx context.Context) ([]*types.Node, error) {
+	endpoint := c.clusterEndpoint + "/_nodes"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.Node{}, errors.Wrap(err, "failed to make NodesInfo request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.Node{}, errors.Wrap(err, "failed to execute NodesInfo request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.Node{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.Node{}, errors.Errorf("failed to execute NodesInfo request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var nodesInfo struct {
+		Nodes map[string]struct {
+			Name       string            `json:"name"`
+			IP         string            `json:"ip"`
+			Attributes map[string]string `json:"attributes"`
+			Roles      []string          `json:"roles"`
+			JVM        struct {
+				StartTimeInMillis int64 `json:"start_time_in_millis"`
+			} `json:"jvm"`
+		} `json:"nodes"`
+	}
+
+	if err := json.Unmarshal(body, &nodesInfo); err != nil {
+		return []*types.Node{}, errors.Wrap(err, "invalid response body")
+	}
+
+	nodes := []*types.Node{}
+
+	for id, node := range nodesInfo.Nodes {
+		attributes := node.Attributes
+		if attributes == nil {
+			attributes = map[string]string{}
+		}
+
+		roles := node.Roles
+		if roles == nil {
+			roles = []string{}
+		}
+
+		nodes = append(nodes, &types.Node{
+			ID:             id,
+			Name:           node.Name,
+			IP:             node.IP,
+			Attributes:     attributes,
+			StartTime:      node.JVM.StartTimeInMillis,
+			MasterEligible: c.isMasterEligible(node.Roles),
+			Roles:          roles,
+		})
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	return nodes, nil
+}
+
+// DiskWatermarks returns disk-based shard allocation settings
+// Settings not returned by cluster settings API are filled with default values
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/modules-cluster.html#disk-based-shard-allocation
+func (c *Client) DiskWatermarks(ctx context.Context) (*types.DiskWatermarks, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/settings?flat_settings=true"
+
+	if c.config.IncludeDefaults {
+		endpoint += "&include_defaults=true"
+	}
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make cluster-settings request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute cluster-settings request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to execute cluster-settings request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var settings struct {
+		Persistent map[string]interface{} `json:"persistent"`
+		Transient  map[string]interface{} `json:"transient"`
+		Defaults   map[string]interface{} `json:"defaults"`
+	}
+
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, errors.Wrap(err, "invalid response body")
+	}
+
+	setting := func(key, defaultValue string) string {
+		// Transient setting takes precedence over persistent one, and persistent one over defaults
+		for _, values := range []map[string]interface{}{settings.Transient, settings.Persistent, settings.Defaults} {
+			if value, ok := values[key].(string); ok && value != "" {
+				return value
+			}
+		}
+
+		return defaultValue
+	}
+
+	return &types.DiskWatermarks{
+		ThresholdEnabled: setting("cluster.routing.allocation.disk.threshold_enabled", "true") == "true",
+		Low:              setting("cluster.routing.allocation.disk.watermark.low", types.DefaultDiskWatermarkLow),
+		High:             setting("cluster.routing.allocation.disk.watermark.high", types.DefaultDiskWatermarkHigh),
+	}, nil
+}
+
+// Flush flushes all indices, with synced flush if configured
+// Some shards may fail to be synced while indexing, which is not an error
+// https://www.elastic.co/guide/en/elasticsearch/reference/7.17/indices-synced-flush-api.html
+func (c *Client) Flush(ctx context.Context) error {
+	endpoint := c.clusterEndpoint + "/_flush"
+
+	if c.config.SyncedFlush {
+		endpoint += "/synced"
+	}
+
+	req, err := http.NewRequest("POST", endpoint, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to make Flush request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to execute Flush request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && !(c.config.SyncedFlush && resp.StatusCode == http.StatusConflict) {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+
+		return errors.Errorf("failed to execute Flush request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
+// ListAllocations returns the number of shards and disk usage of each node
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/cat-allocation.html
+func (c *Client) ListAllocations(ctx context.Context) ([]*types.Allocation, error) {
+	endpoint := c.clusterEndpoint + "/_cat/allocation?format=json&bytes=b&h=shards,disk.used,disk.avail,disk.total,node"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.Allocation{}, errors.Wrap(err, "failed to make cat-allocation request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.Allocation{}, errors.Wrap(err, "failed to execute cat-allocation request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.Allocation{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.Allocation{}, errors.Errorf("failed to execute cat-allocation request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var rows []struct {
+		Shards    string `json:"shards"`
+		DiskUsed  string `json:"disk.used"`
+		DiskAvail string `json:"disk.avail"`
+		DiskTotal string `json:"disk.total"`
+		Node      string `json:"node"`
+	}
+
+	if err := json.Unmarshal(body, &rows); err != nil {
+		return []*types.Allocation{}, errors.Wrap(err, "invalid response body")
+	}
+
+	allocations := []*types.Allocation{}
+
+	for _, row := range rows {
+		// Row of unassigned shards has "UNASSIGNED" node and no disk usage
+		if row.DiskTotal == "" {
+			continue
+		}
+
+		shards, err := strconv.Atoi(row.Shards)
+		if err != nil {
+			return []*types.Allocation{}, errors.Wrapf(err, "invalid shards column %q", row.Shards)
+		}
+
+		diskBytes := []int64{}
+
+		for _, field := range []string{row.DiskUsed, row.DiskAvail, row.DiskTotal} {
+			bytes, err := strconv.ParseInt(field, 10, 64)
+			if err != nil {
+				return []*types.Allocation{}, errors.Wrapf(err, "invalid disk column %q", field)
+			}
+
+			diskBytes = append(diskBytes, bytes)
+		}
+
+		allocations = append(allocations, &types.Allocation{
+			NodeName:           row.Node,
+			Shards:             shards,
+			DiskUsedBytes:      diskBytes[0],
+			DiskAvailableBytes: diskBytes[1],
+			DiskTotalBytes:     diskBytes[2],
+		})
+	}
+
+	return allocations, nil
+}
+
+// ListNodes returns the list of node names
+func (c *Client) ListNodes(ctx context.Context) ([]string, error) {
+	nodesInfo, err := c.DescribeNodes(ctx)
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to describe nodes")
+	}
+
+	nodes := []string{}
+
+	for _, node := range nodesInfo {
+		nodes = append(nodes, node.Name)
+	}
+
+	return nodes, nil
+}
+
+// MasterQuorum returns the last committed voting configuration and voting configuration exclusions
+// Master is elected by a majority of the nodes in the voting configuration
+// If the cluster does not have voting configuration, discovery.zen.minimum_master_nodes is returned instead
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/modules-discovery-voting.html
+func (c *Client) MasterQuorum(ctx context.Context) (*types.MasterQuorum, error) {
+	if c.config.VotingExclusions == VotingExclusionsNone {
+		return c.minimumMasterNodes(ctx)
+	}
+
+	endpoint := c.clusterEndpoint + "/_cluster/state/metadata?filter_path=metadata.cluster_coordination"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make ClusterState request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute ClusterState request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to execute ClusterState request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var clusterState struct {
+		Metadata struct {
+			ClusterCoordination struct {
+				LastCommittedConfig    []string `json:"last_committed_config"`
+				VotingConfigExclusions []struct {
+					NodeID   string `json:"node_id"`
+					NodeName string `json:"node_name"`
+				} `json:"voting_config_exclusions"`
+			} `json:"cluster_coordination"`
+		} `json:"metadata"`
+	}
+
+	if err := json.Unmarshal(body, &clusterState); err != nil {
+		return nil, errors.Wrap(err, "invalid response body")
+	}
+
+	votingConfig := clusterState.Metadata.ClusterCoordination.LastCommittedConfig
+	if votingConfig == nil {
+		votingConfig = []string{}
+	}
+
+	exclusions := []string{}
+
+	for _, exclusion := range clusterState.Metadata.ClusterCoordination.VotingConfigExclusions {
+		exclusions = append(exclusions, exclusion.NodeName)
+	}
+
+	return &types.MasterQuorum{
+		VotingConfig:           votingConfig,
+		VotingConfigExclusions: exclusions,
+	}, nil
+}
+
+// MasterNodeID returns the ID of the elected master node
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-state.html
+func (c *Client) MasterNodeID(ctx context.Context) (string, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/state/master_node"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to make ClusterState request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to execute ClusterState request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to execute ClusterState request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var clusterState struct {
+		MasterNode string `json:"master_node"`
+	}
+
+	if err := json.Unmarshal(body, &clusterState); err != nil {
+		return "", errors.Wrap(err, "invalid response body")
+	}
+
+	return clusterState.MasterNode, nil
+}
+
+// ListNodeStats returns JVM heap usage of each node
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-nodes-stats.html
+func (c *Client) ListNodeStats(ctx context.Context) ([]*types.NodeStats, error) {
+	endpoint := c.clusterEndpoint + "/_nodes/stats/jvm"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.NodeStats{}, errors.Wrap(err, "failed to make NodesStats request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.NodeStats{}, errors.Wrap(err, "failed to execute NodesStats request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.NodeStats{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.NodeStats{}, errors.Errorf("failed to execute NodesStats request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var nodesStats struct {
+		Nodes map[string]struct {
+			Name string `json:"name"`
+			JVM  struct {
+				Mem struct {
+					HeapUsedInBytes int64 `json:"heap_used_in_bytes"`
+					HeapUsedPercent int   `json:"heap_used_percent"`
+					HeapMaxInBytes  int64 `json:"heap_max_in_bytes"`
+				} `json:"mem"`
+			} `json:"jvm"`
+		} `json:"nodes"`
+	}
+
+	if err := json.Unmarshal(body, &nodesStats); err != nil {
+		return []*types.NodeStats{}, errors.Wrap(err, "invalid response body")
+	}
+
+	stats := []*types.NodeStats{}
+
+	for _, node := range nodesStats.Nodes {
+		stats = append(stats, &types.NodeStats{
+			NodeName:        node.Name,
+			HeapUsedBytes:   node.JVM.Mem.HeapUsedInBytes,
+			HeapMaxBytes:    node.JVM.Mem.HeapMaxInBytes,
+			HeapUsedPercent: node.JVM.Mem.HeapUsedPercent,
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].NodeName < stats[j].NodeName
+	})
+
+	return stats, nil
+}
+
+// ListRecoveries returns shard recoveries, including relocations, with the number of bytes recovered
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/cat-recovery.html
+func (c *Client) ListRecoveries(ctx context.Context) ([]*types.Recovery, error) {
+	endpoint := c.clusterEndpoint + "/_cat/recovery?format=json&bytes=b&h=index,shard,stage,bytes,bytes_percent"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.Recovery{}, errors.Wrap(err, "failed to make cat-recovery request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.Recovery{}, errors.Wrap(err, "failed to execute cat-recovery request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.Recovery{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.Recovery{}, errors.Errorf("failed to execute cat-recovery request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var rows []struct {
+		Index        string `json:"index"`
+		Shard        string `json:"shard"`
+		Stage        string `json:"stage"`
+		Bytes        string `json:"bytes"`
+		BytesPercent string `json:"bytes_percent"`
+	}
+
+	if err := json.Unmarshal(body, &rows); err != nil {
+		return []*types.Recovery{}, errors.Wrap(err, "invalid response body")
+	}
+
+	recoveries := []*types.Recovery{}
+
+	for _, row := range rows {
+		recovery, err := parseRecovery(row.Index, row.Shard, row.Stage, row.Bytes, row.BytesPercent)
+		if err != nil {
+			return []*types.Recovery{}, err
+		}
+
+		recoveries = append(recoveries, recovery)
+	}
+
+	return recoveries, nil
+}
+
+// ListShards returns all shards in the cluster with their store size
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/cat-shards.html
+func (c *Client) ListShards(ctx context.Context) ([]*types.Shard, error) {
+	endpoint := c.clusterEndpoint + "/_cat/shards?format=json&bytes=b&h=index,shard,prirep,state,store,node"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []*types.Shard{}, errors.Wrap(err, "failed to make cat-shards request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []*types.Shard{}, errors.Wrap(err, "failed to execute cat-shards request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []*types.Shard{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []*types.Shard{}, errors.Errorf("failed to execute cat-shards request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var rows []struct {
+		Index  string `json:"index"`
+		Shard  string `json:"shard"`
+		Prirep string `json:"prirep"`
+		State  string `json:"state"`
+		Store  string `json:"store"`
+		Node   string `json:"node"`
+	}
+
+	if err := json.Unmarshal(body, &rows); err != nil {
+		return []*types.Shard{}, errors.Wrap(err, "invalid response body")
+	}
+
+	shards := []*types.Shard{}
+
+	for _, row := range rows {
+		shard, err := parseShard(row.Index, row.Shard, row.Prirep, row.State, row.Store, row.Node)
+		if err != nil {
+			return []*types.Shard{}, err
+		}
+
+		shards = append(shards, shard)
+	}
+
+	return shards, nil
+}
+
+// ListShardsOnNode returns the list of shards on the given node
+// Shards relocating from the given node are also included
+func (c *Client) ListShardsOnNode(ctx context.Context, nodeName string) ([]string, error) {
+	endpoint := c.clusterEndpoint + "/_cat/shards?format=json&h=index,shard,prirep,state,node"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to make cat-shards request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to execute cat-shards request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []string{}, errors.Errorf("failed to execute cat-shards request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var shards []struct {
+		Index  string `json:"index"`
+		Shard  string `json:"shard"`
+		Prirep string `json:"prirep"`
+		State  string `json:"state"`
+		Node   string `json:"node"`
+	}
+
+	if err := json.Unmarshal(body, &shards); err != nil {
+		return []string{}, errors.Wrap(err, "invalid response body")
+	}
+
+	shardsOnNode := []string{}
+
+	for _, shard := range shards {
+		// node column of relocating shard looks like "node1 -> 10.0.1.24 Ab1cD2eFG3hIJ4kLMnOpQr node2"
+		if shard.Node == nodeName || strings.HasPrefix(shard.Node, nodeName+" -> ") {
+			shardsOnNode = append(shardsOnNode, strings.Join([]string{shard.Index, shard.Shard, shard.Prirep, shard.State, shard.Node}, " "))
+		}
+	}
+
+	return shardsOnNode, nil
+}
+
+// Shutdown does nothing, because Elasticsearch 6.x or later and OpenSearch do not have shutdown API
+func (c *Client) Shutdown(ctx context.Context, nodeName string) error {
+	return nil
+}
+
+// listExcludedValues returns the list of attribute values currently excluded from shard allocation group
+func (c *Client) listExcludedValues(ctx context.Context, attribute string) ([]string, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/settings?flat_settings=true"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to make cluster-settings request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to execute cluster-settings request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return []string{}, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return []string{}, errors.Errorf("failed to execute cluster-settings request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var settings struct {
+		Persistent map[string]interface{} `json:"persistent"`
+		Transient  map[string]interface{} `json:"transient"`
+	}
+
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return []string{}, errors.Wrap(err, "invalid response body")
+	}
+
+	key := "cluster.routing.allocation.exclude." + attribute
+	values := []string{}
+
+	// Transient setting takes precedence over persistent one
+	value, ok := settings.Transient[key].(string)
+	if !ok {
+		value, ok = settings.Persistent[key].(string)
+	}
+
+	if !ok || value == "" {
+		return values, nil
+	}
+
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values, nil
+}
+
+// minimumMasterNodes returns discovery.zen.minimum_master_nodes
+// Setting updated by cluster update settings API takes precedence over the one in elasticsearch.yml of the elected master
+// https://www.elastic.co/guide/en/elasticsearch/reference/6.8/modules-discovery-zen.html
+func (c *Client) minimumMasterNodes(ctx context.Context) (*types.MasterQuorum, error) {
+	endpoint := c.clusterEndpoint + "/_cluster/settings?flat_settings=true"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make cluster-settings request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute cluster-settings request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to execute cluster-settings request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var settings struct {
+		Persistent map[string]interface{} `json:"persistent"`
+		Transient  map[string]interface{} `json:"transient"`
+	}
+
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, errors.Wrap(err, "invalid response body")
+	}
+
+	key := "discovery.zen.minimum_master_nodes"
+
+	// Transient setting takes precedence over persistent one
+	value, ok := settings.Transient[key]
+	if !ok {
+		value, ok = settings.Persistent[key]
+	}
+
+	if !ok {
+		value, ok, err = c.masterNodeSetting(ctx, key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve settings of master node")
+		}
+	}
+
+	quorum := &types.MasterQuorum{
+		VotingConfig:           []string{},
+		VotingConfigExclusions: []string{},
+	}
+
+	if !ok {
+		return quorum, nil
+	}
+
+	// Setting value is usually string, but may be number if it was updated with number
+	n, err := strconv.Atoi(fmt.Sprint(value))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s %v", key, value)
+	}
+
+	quorum.MinimumMasterNodes = n
+
+	return quorum, nil
+}
+
+// masterNodeSetting returns the given setting of the elected master node, which is configured in its elasticsearch.yml
+func (c *Client) masterNodeSetting(ctx context.Context, key string) (interface{}, bool, error) {
+	endpoint := c.clusterEndpoint + "/_nodes/_master/settings?flat_settings=true"
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to make NodesInfo request")
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to execute NodesInfo request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, errors.Errorf("failed to execute NodesInfo request. code: %d, body: %s", resp.StatusCode, body)
+	}
+
+	var nodesInfo struct {
+		Nodes map[string]struct {
+			Settings map[string]interface{} `json:"settings"`
+		} `json:"nodes"`
+	}
+
+	if err := json.Unmarshal(body, &nodesInfo); err != nil {
+		return nil, false, errors.Wrap(err, "invalid response body")
+	}
+
+	// Only the elected master node is returned
+	for _, node := range nodesInfo.Nodes {
+		if value, ok := node.Settings[key]; ok {
+			return value, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// settingsBody returns the body of cluster update settings request updating the given setting in the configured scope
+// Transient setting takes precedence over persistent one, so updating persistent setting also clears transient one
+// of the same key, which may be left by older versions of this tool or other operations
+func (c *Client) settingsBody(key, value string) (string, error) {
+	settings := map[string]map[string]interface{}{
+		c.config.SettingsScope: map[string]interface{}{
+			key: value,
+		},
+	}
+
+	if c.config.SettingsScope == ScopePersistent {
+		settings[ScopeTransient] = map[string]interface{}{
+			key: nil,
+		}
+	}
+
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode settings")
+	}
+
+	return string(body), nil
+}
+
+// newJSONRequest makes http request with JSON body
+// Elasticsearch 6.x or later and OpenSearch reject request body without Content-Type header
+func newJSONRequest(method, endpoint string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+// parseRecovery builds Recovery from the columns of cat recovery API
+// bytes_percent column looks like "42.5%"
+func parseRecovery(index, shard, stage, bytes, bytesPercent string) (*types.Recovery, error) {
+	n, err := strconv.Atoi(shard)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid shard column %q", shard)
+	}
+
+	b, err := strconv.ParseInt(bytes, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid bytes column %q", bytes)
+	}
+
+	percent, err := strconv.ParseFloat(strings.TrimSuffix(bytesPercent, "%"), 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid bytes_percent column %q", bytesPercent)
+	}
+
+	return &types.Recovery{
+		Index:          index,
+		Shard:          n,
+		Stage:          stage,
+		Bytes:          b,
+		BytesRecovered: int64(float64(b) * percent / 100),
+	}, nil
+}
+
+// parseShard builds Shard from the columns of cat shards API
+func parseShard(index, shard, prirep, state, store, node string) (*types.Shard, error) {
+	n, err := strconv.Atoi(shard)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid shard column %q", shard)
+	}
+
+	s := &types.Shard{
+		Index:   index,
+		Shard:   n,
+		Primary: prirep == "p",
+		State:   state,
+	}
+
+	if store != "" {
+		bytes, err := strconv.ParseInt(store, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid store column %q", store)
+		}
+
+		s.StoreBytes = bytes
+	}
+
+	// node column of relocating shard looks like "node1 -> 10.0.1.24 Ab1cD2eFG3hIJ4kLMnOpQr node2"
+	if i := strings.Index(node, " -> "); i >= 0 {
+		if target := strings.Fields(node[i+len(" -> "):]); len(target) > 2 {
+			s.RelocatingNodeName = strings.Join(target[2:], " ")
+		}
+
+		node = node[:i]
+	}
+
+	s.NodeName = node
+
+	return s, nil
+}
+
+// isMasterEligible returns whether the node having the given roles can be elected as master
+func (c *Client) isMasterEligible(roles []string) bool {
+	for _, role := range roles {
+		for _, masterRole := range c.config.MasterRoles {
+			if role == masterRole {
+				return true
+			}
+		}
+	}
+
+	return false
+}
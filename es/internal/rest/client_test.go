@@ -0,0 +1,803 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/dtan4/esnctl/es/types"
+	"gopkg.in/h2non/gock.v1"
+)
+
+const testClusterEndpoint = "http://example.com:9200"
+
+// testConfig is the configuration of Elasticsearch 7.x, which tests use unless they check the differences
+var testConfig = Config{
+	SettingsScope:    ScopeTransient,
+	VotingExclusions: VotingExclusionsByPath,
+	SyncedFlush:      true,
+	IncludeDefaults:  true,
+	MasterRoles:      []string{"master"},
+}
+
+func newTestClient(config Config) *Client {
+	return &Client{
+		clusterEndpoint: testClusterEndpoint,
+		httpClient:      &http.Client{},
+		config:          config,
+	}
+}
+
+func TestAddVotingConfigExclusions(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Post("/_cluster/voting_config_exclusions/ip-10-0-1-21.ap-northeast-1.compute.internal,ip-10-0-1-22.ap-northeast-1.compute.internal").Reply(200)
+
+	nodeNames := []string{"ip-10-0-1-21.ap-northeast-1.compute.internal", "ip-10-0-1-22.ap-northeast-1.compute.internal"}
+
+	if err := client.AddVotingConfigExclusions(context.Background(), nodeNames); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestAddVotingConfigExclusions_byQuery(t *testing.T) {
+	defer gock.Off()
+
+	config := testConfig
+	config.VotingExclusions = VotingExclusionsByQuery
+	client := newTestClient(config)
+
+	gock.New(testClusterEndpoint).Post("/_cluster/voting_config_exclusions").MatchParam("node_names", "ip-10-0-1-21.ap-northeast-1.compute.internal,ip-10-0-1-22.ap-northeast-1.compute.internal").Reply(200)
+
+	nodeNames := []string{"ip-10-0-1-21.ap-northeast-1.compute.internal", "ip-10-0-1-22.ap-northeast-1.compute.internal"}
+
+	if err := client.AddVotingConfigExclusions(context.Background(), nodeNames); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestAddVotingConfigExclusions_none(t *testing.T) {
+	defer gock.Off()
+
+	config := testConfig
+	config.VotingExclusions = VotingExclusionsNone
+
+	client := newTestClient(config)
+
+	nodeNames := []string{"ip-10-0-1-21.ap-northeast-1.compute.internal"}
+
+	if err := client.AddVotingConfigExclusions(context.Background(), nodeNames); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if err := client.ClearVotingConfigExclusions(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestAllocationSettings(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cluster/settings").MatchParam("flat_settings", "true").Reply(200).BodyString(`{
+  "persistent": {
+    "cluster.routing.allocation.exclude._ip": "10.0.1.10",
+    "cluster.routing.allocation.exclude._name": "ip-10-0-1-10.ap-northeast-1.compute.internal"
+  },
+  "transient": {
+    "cluster.routing.allocation.enable": "none",
+    "cluster.routing.allocation.exclude._name": "ip-10-0-1-23.ap-northeast-1.compute.internal,ip-10-0-1-24.ap-northeast-1.compute.internal",
+    "cluster.routing.allocation.exclude._id": ""
+  }
+}`)
+
+	expected := &types.AllocationSettings{
+		Enable: "none",
+		Exclude: map[string][]string{
+			"_ip":   []string{"10.0.1.10"},
+			"_name": []string{"ip-10-0-1-23.ap-northeast-1.compute.internal", "ip-10-0-1-24.ap-northeast-1.compute.internal"},
+		},
+	}
+
+	got, err := client.AllocationSettings(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("allocation settings does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestClearVotingConfigExclusions(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Delete("/_cluster/voting_config_exclusions").MatchParam("wait_for_removal", "false").Reply(200)
+
+	if err := client.ClearVotingConfigExclusions(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestClusterHealth(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cluster/health").Reply(200).BodyString(`{
+  "cluster_name": "elasticsearch",
+  "status": "yellow",
+  "timed_out": false,
+  "number_of_nodes": 3,
+  "number_of_data_nodes": 3,
+  "active_primary_shards": 5,
+  "active_shards": 9,
+  "relocating_shards": 0,
+  "initializing_shards": 1,
+  "unassigned_shards": 0
+}`)
+
+	expected := &types.ClusterHealth{
+		Status:             "yellow",
+		NumberOfNodes:      3,
+		RelocatingShards:   0,
+		InitializingShards: 1,
+		UnassignedShards:   0,
+	}
+
+	got, err := client.ClusterHealth(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("cluster health does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestDescribeNodes(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_nodes").Reply(200).BodyString(`{
+  "cluster_name": "elasticsearch",
+  "nodes": {
+    "Zy9xW8vUT7sRQ6pONmLkJi": {
+      "name": "ip-10-0-1-24.ap-northeast-1.compute.internal",
+      "ip": "10.0.1.24",
+      "attributes": {"aws_instance_id": "i-5678efab"},
+      "roles": ["data", "ingest"],
+      "jvm": {"pid": 1234, "start_time_in_millis": 1490000000000}
+    },
+    "Ab1cD2eFG3hIJ4kLMnOpQr": {
+      "name": "ip-10-0-1-23.ap-northeast-1.compute.internal",
+      "ip": "10.0.1.23",
+      "roles": ["master", "data"]
+    }
+  }
+}`)
+
+	expected := []*types.Node{
+		&types.Node{
+			ID:             "Ab1cD2eFG3hIJ4kLMnOpQr",
+			Name:           "ip-10-0-1-23.ap-northeast-1.compute.internal",
+			IP:             "10.0.1.23",
+			Attributes:     map[string]string{},
+			MasterEligible: true,
+			Roles:          []string{"master", "data"},
+		},
+		&types.Node{
+			ID:   "Zy9xW8vUT7sRQ6pONmLkJi",
+			Name: "ip-10-0-1-24.ap-northeast-1.compute.internal",
+			IP:   "10.0.1.24",
+			Attributes: map[string]string{
+				"aws_instance_id": "i-5678efab",
+			},
+			StartTime: 1490000000000,
+			Roles:     []string{"data", "ingest"},
+		},
+	}
+
+	got, err := client.DescribeNodes(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("nodes does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestDescribeNodes_clusterManager(t *testing.T) {
+	defer gock.Off()
+
+	config := testConfig
+	config.MasterRoles = []string{"master", "cluster_manager"}
+	client := newTestClient(config)
+
+	gock.New(testClusterEndpoint).Get("/_nodes").Reply(200).BodyString(`{
+  "cluster_name": "elasticsearch",
+  "nodes": {
+    "Zy9xW8vUT7sRQ6pONmLkJi": {
+      "name": "ip-10-0-1-24.ap-northeast-1.compute.internal",
+      "ip": "10.0.1.24",
+      "attributes": {"aws_instance_id": "i-5678efab"},
+      "roles": ["data", "ingest"],
+      "jvm": {"pid": 1234, "start_time_in_millis": 1490000000000}
+    },
+    "Ab1cD2eFG3hIJ4kLMnOpQr": {
+      "name": "ip-10-0-1-23.ap-northeast-1.compute.internal",
+      "ip": "10.0.1.23",
+      "roles": ["cluster_manager", "data"]
+    }
+  }
+}`)
+
+	expected := []*types.Node{
+		&types.Node{
+			ID:             "Ab1cD2eFG3hIJ4kLMnOpQr",
+			Name:           "ip-10-0-1-23.ap-northeast-1.compute.internal",
+			IP:             "10.0.1.23",
+			Attributes:     map[string]string{},
+			MasterEligible: true,
+			Roles:          []string{"cluster_manager", "data"},
+		},
+		&types.Node{
+			ID:   "Zy9xW8vUT7sRQ6pONmLkJi",
+			Name: "ip-10-0-1-24.ap-northeast-1.compute.internal",
+			IP:   "10.0.1.24",
+			Attributes: map[string]string{
+				"aws_instance_id": "i-5678efab",
+			},
+			StartTime: 1490000000000,
+			Roles:     []string{"data", "ingest"},
+		},
+	}
+
+	got, err := client.DescribeNodes(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("nodes does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestDisableReallocation(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Put("/_cluster/settings").MatchType("json").BodyString(`{"transient":{"cluster.routing.allocation.enable":"none"}}`).Reply(200)
+
+	if err := client.DisableReallocation(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestDisableReallocation_persistent(t *testing.T) {
+	defer gock.Off()
+
+	config := testConfig
+	config.SettingsScope = ScopePersistent
+	client := newTestClient(config)
+
+	gock.New(testClusterEndpoint).Put("/_cluster/settings").MatchType("json").BodyString(`{"persistent":{"cluster.routing.allocation.enable":"none"},"transient":{"cluster.routing.allocation.enable":null}}`).Reply(200)
+
+	if err := client.DisableReallocation(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestDiskWatermarks(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cluster/settings").MatchParam("flat_settings", "true").MatchParam("include_defaults", "true").Reply(200).BodyString(`{"persistent":{"cluster.routing.allocation.disk.watermark.high":"50gb"},"transient":{"cluster.routing.allocation.disk.watermark.high":"95%"},"defaults":{"cluster.routing.allocation.disk.threshold_enabled":"true","cluster.routing.allocation.disk.watermark.low":"80%","cluster.routing.allocation.disk.watermark.high":"90%"}}`)
+
+	expected := &types.DiskWatermarks{
+		ThresholdEnabled: true,
+		Low:              "80%",
+		High:             "95%",
+	}
+
+	got, err := client.DiskWatermarks(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("disk watermarks does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestDiskWatermarks_withoutDefaults(t *testing.T) {
+	defer gock.Off()
+
+	config := testConfig
+	config.IncludeDefaults = false
+	client := newTestClient(config)
+
+	gock.New(testClusterEndpoint).Get("/_cluster/settings").MatchParam("flat_settings", "true").Reply(200).BodyString(`{"persistent":{"cluster.routing.allocation.disk.watermark.high":"50gb"},"transient":{"cluster.routing.allocation.disk.watermark.high":"95%"}}`)
+
+	expected := &types.DiskWatermarks{
+		ThresholdEnabled: true,
+		Low:              "85%",
+		High:             "95%",
+	}
+
+	got, err := client.DiskWatermarks(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("disk watermarks does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestEnableReallocation(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Put("/_cluster/settings").MatchType("json").BodyString(`{"transient":{"cluster.routing.allocation.enable":"all"}}`).Reply(200)
+
+	if err := client.EnableReallocation(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestExcludeNodesFromAllocation(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cluster/settings").MatchParam("flat_settings", "true").Reply(200).BodyString(`{"persistent":{},"transient":{"cluster.routing.allocation.exclude._name":"ip-10-0-1-10.ap-northeast-1.compute.internal"}}`)
+	gock.New(testClusterEndpoint).Put("/_cluster/settings").MatchType("json").BodyString(`{"transient":{"cluster.routing.allocation.exclude._name":"ip-10-0-1-10.ap-northeast-1.compute.internal,ip-10-0-1-23.ap-northeast-1.compute.internal,ip-10-0-1-24.ap-northeast-1.compute.internal"}}`).Reply(200)
+
+	nodeNames := []string{
+		"ip-10-0-1-23.ap-northeast-1.compute.internal",
+		"ip-10-0-1-24.ap-northeast-1.compute.internal",
+	}
+
+	if err := client.ExcludeNodesFromAllocation(context.Background(), "_name", nodeNames); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestExcludeNodesFromAllocation_persistent(t *testing.T) {
+	defer gock.Off()
+
+	config := testConfig
+	config.SettingsScope = ScopePersistent
+
+	client := newTestClient(config)
+
+	// Transient setting left by other operations takes precedence, so it is merged and cleared
+	gock.New(testClusterEndpoint).Get("/_cluster/settings").MatchParam("flat_settings", "true").Reply(200).BodyString(`{"persistent":{"cluster.routing.allocation.exclude._name":"ip-10-0-1-11.ap-northeast-1.compute.internal"},"transient":{"cluster.routing.allocation.exclude._name":"ip-10-0-1-10.ap-northeast-1.compute.internal"}}`)
+	gock.New(testClusterEndpoint).Put("/_cluster/settings").MatchType("json").BodyString(`{"persistent":{"cluster.routing.allocation.exclude._name":"ip-10-0-1-10.ap-northeast-1.compute.internal,ip-10-0-1-23.ap-northeast-1.compute.internal"},"transient":{"cluster.routing.allocation.exclude._name":null}}`).Reply(200)
+
+	if err := client.ExcludeNodesFromAllocation(context.Background(), "_name", []string{"ip-10-0-1-23.ap-northeast-1.compute.internal"}); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestFlush(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Post("/_flush/synced").Reply(200).BodyString(`{"_shards":{"total":10,"successful":10,"failed":0}}`)
+
+	if err := client.Flush(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestFlush_conflict(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Post("/_flush/synced").Reply(409).BodyString(`{"_shards":{"total":10,"successful":9,"failed":1}}`)
+
+	if err := client.Flush(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+}
+
+func TestFlush_normal(t *testing.T) {
+	defer gock.Off()
+
+	config := testConfig
+	config.SyncedFlush = false
+	client := newTestClient(config)
+
+	gock.New(testClusterEndpoint).Post("/_flush").Reply(200).BodyString(`{"_shards":{"total":10,"successful":10,"failed":0}}`)
+
+	if err := client.Flush(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestIncludeNodesInAllocation(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cluster/settings").MatchParam("flat_settings", "true").Reply(200).BodyString(`{"persistent":{},"transient":{"cluster.routing.allocation.exclude._name":"ip-10-0-1-23.ap-northeast-1.compute.internal,ip-10-0-1-24.ap-northeast-1.compute.internal"}}`)
+	gock.New(testClusterEndpoint).Put("/_cluster/settings").MatchType("json").BodyString(`{"transient":{"cluster.routing.allocation.exclude._name":"ip-10-0-1-24.ap-northeast-1.compute.internal"}}`).Reply(200)
+
+	nodeNames := []string{
+		"ip-10-0-1-23.ap-northeast-1.compute.internal",
+	}
+
+	if err := client.IncludeNodesInAllocation(context.Background(), "_name", nodeNames); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestListAllocations(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cat/allocation").MatchParam("format", "json").Reply(200).BodyString(`[
+  {"shards": "12", "disk.used": "5368709120", "disk.avail": "48318382080", "disk.total": "53687091200", "node": "ip-10-0-1-23.ap-northeast-1.compute.internal"},
+  {"shards": "8", "disk.used": "3221225472", "disk.avail": "50465865728", "disk.total": "53687091200", "node": "ip-10-0-1-24.ap-northeast-1.compute.internal"},
+  {"shards": "2", "disk.used": null, "disk.avail": null, "disk.total": null, "node": "UNASSIGNED"}
+]`)
+
+	expected := []*types.Allocation{
+		&types.Allocation{
+			NodeName:           "ip-10-0-1-23.ap-northeast-1.compute.internal",
+			Shards:             12,
+			DiskUsedBytes:      5368709120,
+			DiskAvailableBytes: 48318382080,
+			DiskTotalBytes:     53687091200,
+		},
+		&types.Allocation{
+			NodeName:           "ip-10-0-1-24.ap-northeast-1.compute.internal",
+			Shards:             8,
+			DiskUsedBytes:      3221225472,
+			DiskAvailableBytes: 50465865728,
+			DiskTotalBytes:     53687091200,
+		},
+	}
+
+	got, err := client.ListAllocations(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("allocations does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestListNodeStats(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_nodes/stats/jvm").Reply(200).BodyString(`{
+  "cluster_name": "elasticsearch",
+  "nodes": {
+    "Zy9xW8vUT7sRQ6pONmLkJi": {
+      "name": "ip-10-0-1-24.ap-northeast-1.compute.internal",
+      "jvm": {"mem": {"heap_used_in_bytes": 1610612736, "heap_used_percent": 75, "heap_max_in_bytes": 2147483648}}
+    },
+    "Ab1cD2eFG3hIJ4kLMnOpQr": {
+      "name": "ip-10-0-1-23.ap-northeast-1.compute.internal",
+      "jvm": {"mem": {"heap_used_in_bytes": 536870912, "heap_used_percent": 25, "heap_max_in_bytes": 2147483648}}
+    }
+  }
+}`)
+
+	expected := []*types.NodeStats{
+		&types.NodeStats{
+			NodeName:        "ip-10-0-1-23.ap-northeast-1.compute.internal",
+			HeapUsedBytes:   536870912,
+			HeapMaxBytes:    2147483648,
+			HeapUsedPercent: 25,
+		},
+		&types.NodeStats{
+			NodeName:        "ip-10-0-1-24.ap-northeast-1.compute.internal",
+			HeapUsedBytes:   1610612736,
+			HeapMaxBytes:    2147483648,
+			HeapUsedPercent: 75,
+		},
+	}
+
+	got, err := client.ListNodeStats(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("node stats does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestListNodes(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_nodes").Reply(200).BodyString(`{
+  "_nodes": {"total": 2, "successful": 2, "failed": 0},
+  "cluster_name": "elasticsearch",
+  "nodes": {
+    "Ab1cD2eFG3hIJ4kLMnOpQr": {"name": "ip-10-0-1-23.ap-northeast-1.compute.internal", "ip": "10.0.1.23"},
+    "Zy9xW8vUT7sRQ6pONmLkJi": {"name": "ip-10-0-1-24.ap-northeast-1.compute.internal", "ip": "10.0.1.24"}
+  }
+}`)
+
+	expected := []string{
+		"ip-10-0-1-23.ap-northeast-1.compute.internal",
+		"ip-10-0-1-24.ap-northeast-1.compute.internal",
+	}
+
+	got, err := client.ListNodes(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("nodes does not match. expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestListRecoveries(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cat/recovery").MatchParam("format", "json").Reply(200).BodyString(`[
+  {"index": "wiki1", "shard": "0", "stage": "done", "bytes": "1073741824", "bytes_percent": "100.0%"},
+  {"index": "wiki1", "shard": "1", "stage": "index", "bytes": "2147483648", "bytes_percent": "25.0%"}
+]`)
+
+	expected := []*types.Recovery{
+		&types.Recovery{
+			Index:          "wiki1",
+			Shard:          0,
+			Stage:          "done",
+			Bytes:          1073741824,
+			BytesRecovered: 1073741824,
+		},
+		&types.Recovery{
+			Index:          "wiki1",
+			Shard:          1,
+			Stage:          "index",
+			Bytes:          2147483648,
+			BytesRecovered: 536870912,
+		},
+	}
+
+	got, err := client.ListRecoveries(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("recoveries does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestListShards(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cat/shards").MatchParam("format", "json").Reply(200).BodyString(`[
+  {"index": "wiki1", "shard": "0", "prirep": "p", "state": "STARTED", "store": "1073741824", "node": "ip-10-0-1-23.ap-northeast-1.compute.internal"},
+  {"index": "wiki1", "shard": "1", "prirep": "p", "state": "RELOCATING", "store": "2147483648", "node": "ip-10-0-1-23.ap-northeast-1.compute.internal -> 10.0.1.24 Zy9xW8vUT7sRQ6pONmLkJi ip-10-0-1-24.ap-northeast-1.compute.internal"},
+  {"index": "wiki1", "shard": "1", "prirep": "r", "state": "UNASSIGNED", "store": null, "node": null}
+]`)
+
+	expected := []*types.Shard{
+		&types.Shard{
+			Index:      "wiki1",
+			Shard:      0,
+			Primary:    true,
+			State:      "STARTED",
+			StoreBytes: 1073741824,
+			NodeName:   "ip-10-0-1-23.ap-northeast-1.compute.internal",
+		},
+		&types.Shard{
+			Index:              "wiki1",
+			Shard:              1,
+			Primary:            true,
+			State:              "RELOCATING",
+			StoreBytes:         2147483648,
+			NodeName:           "ip-10-0-1-23.ap-northeast-1.compute.internal",
+			RelocatingNodeName: "ip-10-0-1-24.ap-northeast-1.compute.internal",
+		},
+		&types.Shard{
+			Index: "wiki1",
+			Shard: 1,
+			State: "UNASSIGNED",
+		},
+	}
+
+	got, err := client.ListShards(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("shards does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestListShardsOnNode(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cat/shards").MatchParam("format", "json").Reply(200).BodyString(`[
+  {"index": "wiki1", "shard": "0", "prirep": "p", "state": "STARTED", "node": "ip-10-0-1-23.ap-northeast-1.compute.internal"},
+  {"index": "wiki1", "shard": "1", "prirep": "p", "state": "RELOCATING", "node": "ip-10-0-1-23.ap-northeast-1.compute.internal -> 10.0.1.24 Zy9xW8vUT7sRQ6pONmLkJi ip-10-0-1-24.ap-northeast-1.compute.internal"},
+  {"index": "wiki1", "shard": "2", "prirep": "p", "state": "STARTED", "node": "ip-10-0-1-24.ap-northeast-1.compute.internal"},
+  {"index": "wiki1", "shard": "2", "prirep": "r", "state": "UNASSIGNED", "node": null}
+]`)
+
+	nodeName := "ip-10-0-1-23.ap-northeast-1.compute.internal"
+
+	shards, err := client.ListShardsOnNode(context.Background(), nodeName)
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	expected := []string{
+		"wiki1 0 p STARTED ip-10-0-1-23.ap-northeast-1.compute.internal",
+		"wiki1 1 p RELOCATING ip-10-0-1-23.ap-northeast-1.compute.internal -> 10.0.1.24 Zy9xW8vUT7sRQ6pONmLkJi ip-10-0-1-24.ap-northeast-1.compute.internal",
+	}
+
+	if !reflect.DeepEqual(shards, expected) {
+		t.Errorf("shards does not match. expected: %q, got: %q", expected, shards)
+	}
+}
+
+func TestMasterNodeID(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cluster/state/master_node").Reply(200).BodyString(`{"cluster_name":"elasticsearch","master_node":"Ab1cD2eFG3hIJ4kLMnOpQr"}`)
+
+	expected := "Ab1cD2eFG3hIJ4kLMnOpQr"
+
+	got, err := client.MasterNodeID(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("master node ID does not match. expected: %q, got: %q", expected, got)
+	}
+}
+func TestMasterQuorum(t *testing.T) {
+	defer gock.Off()
+
+	client := newTestClient(testConfig)
+
+	gock.New(testClusterEndpoint).Get("/_cluster/state/metadata").Reply(200).BodyString(`{
+  "metadata": {
+    "cluster_coordination": {
+      "last_committed_config": ["Ab1cD2eFG3hIJ4kLMnOpQr", "Zy9xW8vUT7sRQ6pONmLkJi", "Mn0pQ1rST2uVW3xYZ4aBcD"],
+      "voting_config_exclusions": [
+        {"node_id": "Qr4sT5uVW6xYZ7aBcD8eFg", "node_name": "ip-10-0-1-24.ap-northeast-1.compute.internal"}
+      ]
+    }
+  }
+}`)
+
+	expected := &types.MasterQuorum{
+		VotingConfig:           []string{"Ab1cD2eFG3hIJ4kLMnOpQr", "Zy9xW8vUT7sRQ6pONmLkJi", "Mn0pQ1rST2uVW3xYZ4aBcD"},
+		VotingConfigExclusions: []string{"ip-10-0-1-24.ap-northeast-1.compute.internal"},
+	}
+
+	got, err := client.MasterQuorum(context.Background())
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("master quorum does not match. expected: %#v, got: %#v", expected, got)
+	}
+}
+
+func TestMasterQuorum_minimumMasterNodes(t *testing.T) {
+	defer gock.Off()
+
+	config := testConfig
+	config.VotingExclusions = VotingExclusionsNone
+	client := newTestClient(config)
+
+	testcases := []struct {
+		settings     string
+		nodeSettings string
+		expected     int
+	}{
+		{
+			settings: `{"persistent":{"discovery.zen.minimum_master_nodes":"2"},"transient":{"discovery.zen.minimum_master_nodes":"3"}}`,
+			expected: 3,
+		},
+		{
+			settings:     `{"persistent":{},"transient":{}}`,
+			nodeSettings: `{"cluster_name":"elasticsearch","nodes":{"Ab1cD2eFG3hIJ4kLMnOpQr":{"settings":{"discovery.zen.minimum_master_nodes":"2","node.master":"true"}}}}`,
+			expected:     2,
+		},
+		{
+			settings:     `{"persistent":{},"transient":{}}`,
+			nodeSettings: `{"cluster_name":"elasticsearch","nodes":{"Ab1cD2eFG3hIJ4kLMnOpQr":{"settings":{"node.master":"true"}}}}`,
+			expected:     0,
+		},
+	}
+
+	for _, tc := range testcases {
+		gock.New(testClusterEndpoint).Get("/_cluster/settings").MatchParam("flat_settings", "true").Reply(200).BodyString(tc.settings)
+
+		if tc.nodeSettings != "" {
+			gock.New(testClusterEndpoint).Get("/_nodes/_master/settings").Reply(200).BodyString(tc.nodeSettings)
+		}
+
+		got, err := client.MasterQuorum(context.Background())
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+			continue
+		}
+
+		if got.MinimumMasterNodes != tc.expected {
+			t.Errorf("minimum master nodes does not match. expected: %d, got: %d", tc.expected, got.MinimumMasterNodes)
+		}
+
+		if len(got.VotingConfig) != 0 {
+			t.Errorf("voting config should be empty, got: %q", got.VotingConfig)
+		}
+	}
+}
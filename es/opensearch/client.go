@@ -0,0 +1,31 @@
+package opensearch
+
+import (
+	"net/http"
+
+	"github.com/dtan4/esnctl/es/internal/rest"
+)
+
+// Client represents OpenSearch API client
+type Client struct {
+	*rest.Client
+}
+
+// NewClient creates new Client object
+func NewClient(clusterURL string, httpClient *http.Client) (*Client, error) {
+	client, err := rest.NewClient(clusterURL, httpClient, rest.Config{
+		SettingsScope:    rest.ScopeTransient,
+		VotingExclusions: rest.VotingExclusionsByQuery,
+		SyncedFlush:      false,
+		IncludeDefaults:  true,
+		// OpenSearch 2.x renamed master role to cluster_manager
+		MasterRoles: []string{"master", "cluster_manager"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		Client: client,
+	}, nil
+}
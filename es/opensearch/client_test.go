@@ -0,0 +1,113 @@
+package opensearch
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+)
+
+const testClusterEndpoint = "http://example.com:9200"
+
+// Only the differences from other versions are tested here. Others are tested in es/internal/rest
+
+func TestAddVotingConfigExclusions(t *testing.T) {
+	defer gock.Off()
+
+	client, err := NewClient(testClusterEndpoint, &http.Client{})
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	gock.New(testClusterEndpoint).Post("/_cluster/voting_config_exclusions").MatchParam("node_names", "ip-10-0-1-21.ap-northeast-1.compute.internal,ip-10-0-1-22.ap-northeast-1.compute.internal").Reply(200)
+
+	nodeNames := []string{"ip-10-0-1-21.ap-northeast-1.compute.internal", "ip-10-0-1-22.ap-northeast-1.compute.internal"}
+
+	if err := client.AddVotingConfigExclusions(context.Background(), nodeNames); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestDescribeNodes(t *testing.T) {
+	defer gock.Off()
+
+	client, err := NewClient(testClusterEndpoint, &http.Client{})
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	gock.New(testClusterEndpoint).Get("/_nodes").Reply(200).BodyString(`{
+  "nodes": {
+    "Ab1cD2eFG3hIJ4kLMnOpQr": {
+      "name": "ip-10-0-1-21.ap-northeast-1.compute.internal",
+      "ip": "10.0.1.21",
+      "roles": ["cluster_manager", "data"]
+    },
+    "Zy9xW8vUT7sRQ6pONmLkJi": {
+      "name": "ip-10-0-1-22.ap-northeast-1.compute.internal",
+      "ip": "10.0.1.22",
+      "roles": ["data"]
+    }
+  }
+}`)
+
+	nodes, err := client.DescribeNodes(context.Background())
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	if len(nodes) != 2 {
+		t.Fatalf("number of nodes does not match. expected: 2, got: %d", len(nodes))
+	}
+
+	if !nodes[0].MasterEligible {
+		t.Errorf("node having cluster_manager role should be master-eligible")
+	}
+
+	if nodes[1].MasterEligible {
+		t.Errorf("node not having cluster_manager role should not be master-eligible")
+	}
+}
+
+func TestDisableReallocation(t *testing.T) {
+	defer gock.Off()
+
+	client, err := NewClient(testClusterEndpoint, &http.Client{})
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	gock.New(testClusterEndpoint).Put("/_cluster/settings").MatchType("json").BodyString(`{"transient":{"cluster.routing.allocation.enable":"none"}}`).Reply(200)
+
+	if err := client.DisableReallocation(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestFlush(t *testing.T) {
+	defer gock.Off()
+
+	client, err := NewClient(testClusterEndpoint, &http.Client{})
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	gock.New(testClusterEndpoint).Post("/_flush").Reply(200).BodyString(`{"_shards":{"total":10,"successful":10,"failed":0}}`)
+
+	if err := client.Flush(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Node represents Elasticsearch node
+type Node struct {
+	ID         string
+	Name       string
+	IP         string
+	Attributes map[string]string
+	// StartTime is the time JVM started at, in milliseconds since the epoch
+	StartTime int64
+	// MasterEligible is whether the node can be elected as master
+	MasterEligible bool
+	// Roles are the roles of the node as reported by Elasticsearch, e.g. "master", "data" and "ingest"
+	Roles []string
+}
+
+// AllocationFilterValue returns the value of the given allocation filter attribute
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/allocation-filtering.html
+func (n *Node) AllocationFilterValue(attribute string) string {
+	switch attribute {
+	case "_name":
+		return n.Name
+	case "_ip":
+		return n.IP
+	case "_id":
+		return n.ID
+	}
+
+	return n.Attributes[attribute]
+}
+
+// HasRole returns whether the node has the given role
+// "data" also matches data tier roles of Elasticsearch 7.10 or later, e.g. "data_hot" and "data_content"
+// "master" and "cluster_manager", which OpenSearch 2.x renamed master role to, match each other
+func (n *Node) HasRole(role string) bool {
+	for _, r := range n.Roles {
+		if r == role || (role == "data" && strings.HasPrefix(r, "data_")) || (isMasterRole(role) && isMasterRole(r)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMasterRole(role string) bool {
+	return role == "master" || role == "cluster_manager"
+}
+
+// AllocationSettings represents cluster-wide shard allocation settings
+type AllocationSettings struct {
+	// Enable is the value of cluster.routing.allocation.enable, "all" if not set
+	Enable string
+	// Exclude is the values of cluster.routing.allocation.exclude.* keyed by attribute, e.g. "_name"
+	Exclude map[string][]string
+}
+
+// ClusterHealth represents health status of Elasticsearch cluster
+type ClusterHealth struct {
+	Status             string `json:"status"`
+	NumberOfNodes      int    `json:"number_of_nodes"`
+	RelocatingShards   int    `json:"relocating_shards"`
+	InitializingShards int    `json:"initializing_shards"`
+	UnassignedShards   int    `json:"unassigned_shards"`
+}
+
+// IsGreen returns whether the cluster is green and no shard is moving
+func (h *ClusterHealth) IsGreen() bool {
+	return h.Status == "green" && h.RelocatingShards == 0 && h.InitializingShards == 0
+}
+
+// Allocation represents the number of shards and disk usage of node
+type Allocation struct {
+	NodeName           string
+	Shards             int
+	DiskUsedBytes      int64
+	DiskAvailableBytes int64
+	DiskTotalBytes     int64
+}
+
+// NodeStats represents JVM heap usage of node
+type NodeStats struct {
+	NodeName        string
+	HeapUsedBytes   int64
+	HeapMaxBytes    int64
+	HeapUsedPercent int
+}
+
+// MasterQuorum represents how many master-eligible nodes are required to elect master
+type MasterQuorum struct {
+	// MinimumMasterNodes is discovery.zen.minimum_master_nodes of Elasticsearch 6.x or earlier, 0 if not set
+	MinimumMasterNodes int
+	// VotingConfig is the IDs of nodes in the voting configuration of Elasticsearch 7.x or later
+	// Master is elected by a majority of them
+	VotingConfig []string
+	// VotingConfigExclusions is the names of nodes excluded from the voting configuration of Elasticsearch 7.x or later
+	VotingConfigExclusions []string
+}
+
+// Shard represents a copy of shard and its location
+type Shard struct {
+	Index   string
+	Shard   int
+	Primary bool
+	// State is one of "STARTED", "RELOCATING", "INITIALIZING" or "UNASSIGNED"
+	State      string
+	StoreBytes int64
+	// NodeName is empty if the shard is unassigned, and the source node if the shard is relocating
+	NodeName string
+	// RelocatingNodeName is the target node if the shard is relocating
+	RelocatingNodeName string
+}
+
+// Recovery represents recovery of shard, which is how shards are relocated
+type Recovery struct {
+	Index string
+	Shard int
+	// Stage is "done" if the recovery has finished
+	Stage string
+	// Bytes is the number of bytes to recover
+	Bytes          int64
+	BytesRecovered int64
+}
+
+// Default disk watermarks of Elasticsearch, used if they are not configured
+const (
+	DefaultDiskWatermarkLow  = "85%"
+	DefaultDiskWatermarkHigh = "90%"
+)
+
+// DiskWatermarks represents disk-based shard allocation settings
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/modules-cluster.html#disk-based-shard-allocation
+type DiskWatermarks struct {
+	// ThresholdEnabled is false if shards are allocated regardless of disk usage
+	ThresholdEnabled bool
+	Low              string
+	High             string
+}
+
+var byteUnits = []struct {
+	suffix     string
+	multiplier int64
+}{
+	// Longer suffixes must come first, because every suffix ends with "b"
+	{"pb", 1 << 50},
+	{"tb", 1 << 40},
+	{"gb", 1 << 30},
+	{"mb", 1 << 20},
+	{"kb", 1 << 10},
+	{"b", 1},
+}
+
+// UsableBytes returns how many bytes can be used on disk of total bytes without exceeding the given watermark
+// watermark is either a percentage ("90%"), a ratio ("0.9") or free space to keep ("50gb")
+func UsableBytes(watermark string, total int64) (int64, error) {
+	w := strings.ToLower(strings.TrimSpace(watermark))
+
+	if strings.HasSuffix(w, "%") {
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(w, "%"), 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid disk watermark %q", watermark)
+		}
+
+		return int64(float64(total) * percent / 100), nil
+	}
+
+	if ratio, err := strconv.ParseFloat(w, 64); err == nil {
+		return int64(float64(total) * ratio), nil
+	}
+
+	for _, unit := range byteUnits {
+		if !strings.HasSuffix(w, unit.suffix) {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(w, unit.suffix)), 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid disk watermark %q", watermark)
+		}
+
+		usable := total - int64(value*float64(unit.multiplier))
+		if usable < 0 {
+			usable = 0
+		}
+
+		return usable, nil
+	}
+
+	return 0, errors.Errorf("invalid disk watermark %q", watermark)
+}
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"testing"
+)
+
+func TestAllocationFilterValue(t *testing.T) {
+	node := &Node{
+		ID:   "Ab1cD2eFG3hIJ4kLMnOpQr",
+		Name: "ip-10-0-1-23.ap-northeast-1.compute.internal",
+		IP:   "10.0.1.23",
+		Attributes: map[string]string{
+			"aws_instance_id": "i-1234abcd",
+		},
+	}
+
+	testcases := []struct {
+		attribute string
+		expected  string
+	}{
+		{
+			attribute: "_name",
+			expected:  "ip-10-0-1-23.ap-northeast-1.compute.internal",
+		},
+		{
+			attribute: "_ip",
+			expected:  "10.0.1.23",
+		},
+		{
+			attribute: "_id",
+			expected:  "Ab1cD2eFG3hIJ4kLMnOpQr",
+		},
+		{
+			attribute: "aws_instance_id",
+			expected:  "i-1234abcd",
+		},
+		{
+			attribute: "rack_id",
+			expected:  "",
+		},
+	}
+
+	for _, tc := range testcases {
+		if got := node.AllocationFilterValue(tc.attribute); got != tc.expected {
+			t.Errorf("value of %q does not match. expected: %q, got: %q", tc.attribute, tc.expected, got)
+		}
+	}
+}
+
+func TestHasRole(t *testing.T) {
+	testcases := []struct {
+		roles    []string
+		role     string
+		expected bool
+	}{
+		{[]string{"master", "data", "ingest"}, "data", true},
+		{[]string{"master", "data", "ingest"}, "master", true},
+		{[]string{"master"}, "data", false},
+		{[]string{"data_hot", "data_content", "ingest"}, "data", true},
+		{[]string{"data_hot"}, "data_content", false},
+		{[]string{"cluster_manager", "data"}, "master", true},
+		{[]string{"master", "data"}, "cluster_manager", true},
+		{[]string{"data"}, "cluster_manager", false},
+		// Coordinating only node has no role
+		{[]string{}, "data", false},
+	}
+
+	for _, tc := range testcases {
+		node := &Node{
+			Roles: tc.roles,
+		}
+
+		if got := node.HasRole(tc.role); got != tc.expected {
+			t.Errorf("HasRole(%q) of %q does not match. expected: %t, got: %t", tc.role, tc.roles, tc.expected, got)
+		}
+	}
+}
+
+func TestIsGreen(t *testing.T) {
+	testcases := []struct {
+		health   *ClusterHealth
+		expected bool
+	}{
+		{
+			health:   &ClusterHealth{Status: "green"},
+			expected: true,
+		},
+		{
+			health:   &ClusterHealth{Status: "yellow"},
+			expected: false,
+		},
+		{
+			health:   &ClusterHealth{Status: "green", RelocatingShards: 2},
+			expected: false,
+		},
+		{
+			health:   &ClusterHealth{Status: "green", InitializingShards: 1},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		if got := tc.health.IsGreen(); got != tc.expected {
+			t.Errorf("result does not match. health: %#v, expected: %t, got: %t", tc.health, tc.expected, got)
+		}
+	}
+}
+
+func TestUsableBytes(t *testing.T) {
+	var total int64 = 100 * (1 << 30)
+
+	testcases := []struct {
+		watermark string
+		expected  int64
+	}{
+		{
+			watermark: "90%",
+			expected:  90 * (1 << 30),
+		},
+		{
+			watermark: "87.5%",
+			expected:  87*(1<<30) + (1 << 29),
+		},
+		{
+			watermark: "0.85",
+			expected:  85 * (1 << 30),
+		},
+		{
+			watermark: "20gb",
+			expected:  80 * (1 << 30),
+		},
+		{
+			watermark: "512MB",
+			expected:  99*(1<<30) + (1 << 29),
+		},
+		{
+			watermark: "1tb",
+			expected:  0,
+		},
+	}
+
+	for _, tc := range testcases {
+		got, err := UsableBytes(tc.watermark, total)
+		if err != nil {
+			t.Errorf("error should not be raised: %s", err)
+		}
+
+		if got != tc.expected {
+			t.Errorf("usable bytes does not match. watermark: %q, expected: %d, got: %d", tc.watermark, tc.expected, got)
+		}
+	}
+}
+
+func TestUsableBytes_invalid(t *testing.T) {
+	for _, watermark := range []string{"", "ninety%", "20xb"} {
+		if _, err := UsableBytes(watermark, 100); err == nil {
+			t.Errorf("error should be raised. watermark: %q", watermark)
+		}
+	}
+}
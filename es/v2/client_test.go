@@ -1,91 +1,123 @@
 package v2
 
 import (
+	"context"
 	"net/http"
+	"reflect"
 	"testing"
 
+	"github.com/dtan4/esnctl/es/internal/legacy"
+	"github.com/dtan4/esnctl/es/types"
 	"gopkg.in/h2non/gock.v1"
 )
 
 const testClusterEndpoint = "http://example.com:9200"
 
-func TestDisableReallocation(t *testing.T) {
-	defer gock.Off()
+// Only the differences from other versions are tested here. Others are tested in es/internal/legacy
 
-	client := &Client{
-		client:          nil,
-		clusterEndpoint: testClusterEndpoint,
-		httpClient:      &http.Client{},
+// newTestClient creates Client without olivere/elastic client, which connects to the cluster on creation
+func newTestClient(t *testing.T) *Client {
+	client, err := legacy.NewClient(testClusterEndpoint, &http.Client{}, config)
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
 	}
 
-	gock.New(testClusterEndpoint).Put("/_cluster/settings").BodyString(`{"transient":{"cluster.routing.allocation.enable":"none"}}`).Reply(200)
-
-	if err := client.DisableReallocation(); err != nil {
-		t.Errorf("error should not be raised: %s", err)
+	return &Client{
+		Client: client,
 	}
 }
 
-func TestEnableReallocation(t *testing.T) {
+func TestDescribeNodes(t *testing.T) {
 	defer gock.Off()
 
-	client := &Client{
-		client:          nil,
-		clusterEndpoint: testClusterEndpoint,
-		httpClient:      &http.Client{},
+	client := newTestClient(t)
+
+	gock.New(testClusterEndpoint).Get("/_nodes").Reply(200).BodyString(`{
+  "cluster_name": "elasticsearch",
+  "nodes": {
+    "Zy9xW8vUT7sRQ6pONmLkJi": {
+      "name": "ip-10-0-1-24.ap-northeast-1.compute.internal",
+      "ip": "10.0.1.24",
+      "attributes": {"aws_instance_id": "i-5678efab", "master": "false"},
+      "jvm": {"pid": 1234, "start_time_in_millis": 1490000000000}
+    },
+    "Ab1cD2eFG3hIJ4kLMnOpQr": {
+      "name": "ip-10-0-1-23.ap-northeast-1.compute.internal",
+      "ip": "10.0.1.23"
+    }
+  }
+}`)
+
+	expected := []*types.Node{
+		&types.Node{
+			ID:             "Ab1cD2eFG3hIJ4kLMnOpQr",
+			Name:           "ip-10-0-1-23.ap-northeast-1.compute.internal",
+			IP:             "10.0.1.23",
+			Attributes:     map[string]string{},
+			MasterEligible: true,
+			Roles:          []string{"master", "data"},
+		},
+		&types.Node{
+			ID:   "Zy9xW8vUT7sRQ6pONmLkJi",
+			Name: "ip-10-0-1-24.ap-northeast-1.compute.internal",
+			IP:   "10.0.1.24",
+			Attributes: map[string]string{
+				"aws_instance_id": "i-5678efab",
+				"master":          "false",
+			},
+			StartTime:      1490000000000,
+			Roles:          []string{"data"},
+			MasterEligible: false,
+		},
 	}
 
-	gock.New(testClusterEndpoint).Put("/_cluster/settings").BodyString(`{"transient":{"cluster.routing.allocation.enable":"all"}}`).Reply(200)
-
-	if err := client.EnableReallocation(); err != nil {
+	got, err := client.DescribeNodes(context.Background())
+	if err != nil {
 		t.Errorf("error should not be raised: %s", err)
 	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("nodes does not match. expected: %#v, got: %#v", expected, got)
+	}
 }
 
-func TestExcludeNodeFromAllocation(t *testing.T) {
+func TestFlush(t *testing.T) {
 	defer gock.Off()
 
-	client := &Client{
-		client:          nil,
-		clusterEndpoint: testClusterEndpoint,
-		httpClient:      &http.Client{},
-	}
-
-	gock.New(testClusterEndpoint).Put("/_cluster/settings").BodyString(`{"transient":{"cluster.routing.allocation.exclude._name":"ip-10-0-1-23.ap-northeast-1.compute.internal"}}`).Reply(200)
+	client := newTestClient(t)
 
-	nodeName := "ip-10-0-1-23.ap-northeast-1.compute.internal"
+	gock.New(testClusterEndpoint).Post("/_flush/synced").Reply(200).BodyString(`{"_shards":{"total":10,"successful":10,"failed":0}}`)
 
-	if err := client.ExcludeNodeFromAllocation(nodeName); err != nil {
+	if err := client.Flush(context.Background()); err != nil {
 		t.Errorf("error should not be raised: %s", err)
 	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
 }
 
-func TestListShardsOnNode(t *testing.T) {
+func TestFlush_conflict(t *testing.T) {
 	defer gock.Off()
 
-	client := &Client{
-		client:          nil,
-		clusterEndpoint: testClusterEndpoint,
-		httpClient:      &http.Client{},
-	}
-
-	gock.New(testClusterEndpoint).Get("/_cat/shards").Reply(200).BodyString(`wiki1 0 p STARTED 3014 31.1mb 192.168.56.10 ip-10-0-1-23.ap-northeast-1.compute.internal
-wiki1 1 p STARTED 3013 29.6mb 192.168.56.30 Frankie Raye
-wiki1 2 p STARTED 3973 38.1mb 192.168.56.20 Commander Kraken`)
+	client := newTestClient(t)
 
-	nodeName := "ip-10-0-1-23.ap-northeast-1.compute.internal"
+	gock.New(testClusterEndpoint).Post("/_flush/synced").Reply(409).BodyString(`{"_shards":{"total":10,"successful":9,"failed":1}}`)
 
-	shards, err := client.ListShardsOnNode(nodeName)
-	if err != nil {
+	if err := client.Flush(context.Background()); err != nil {
 		t.Errorf("error should not be raised: %s", err)
 	}
+}
 
-	if len(shards) != 1 {
-		t.Errorf("number of shards does not match. expected: 1, got: %d", len(shards))
-	}
+func TestShutdown(t *testing.T) {
+	defer gock.Off()
 
-	expected := "wiki1 0 p STARTED 3014 31.1mb 192.168.56.10 ip-10-0-1-23.ap-northeast-1.compute.internal"
+	client := newTestClient(t)
 
-	if shards[0] != expected {
-		t.Errorf("shard does not match. expected: %q, got: %q", expected, shards[0])
+	// No request is made, because Elasticsearch 2.x does not have shutdown API
+	nodeName := "ip-10-0-1-23.ap-northeast-1.compute.internal"
+
+	if err := client.Shutdown(context.Background(), nodeName); err != nil {
+		t.Errorf("error should not be raised: %s", err)
 	}
 }
@@ -0,0 +1,32 @@
+package v6
+
+import (
+	"net/http"
+
+	"github.com/dtan4/esnctl/es/internal/rest"
+)
+
+// Client represents Elasticsearch 6.x API client
+type Client struct {
+	*rest.Client
+}
+
+// NewClient creates new Client object
+func NewClient(clusterURL string, httpClient *http.Client) (*Client, error) {
+	client, err := rest.NewClient(clusterURL, httpClient, rest.Config{
+		SettingsScope: rest.ScopeTransient,
+		// Elasticsearch 6.x elects master by discovery.zen.minimum_master_nodes instead of voting configuration
+		VotingExclusions: rest.VotingExclusionsNone,
+		SyncedFlush:      true,
+		// Settings in elasticsearch.yml are not visible via API, and include_defaults is not available before 6.4
+		IncludeDefaults: false,
+		MasterRoles:     []string{"master"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package v7
+
+import (
+	"net/http"
+
+	"github.com/dtan4/esnctl/es/internal/rest"
+)
+
+// Client represents Elasticsearch 7.x API client
+type Client struct {
+	*rest.Client
+}
+
+// NewClient creates new Client object
+func NewClient(clusterURL string, httpClient *http.Client) (*Client, error) {
+	client, err := rest.NewClient(clusterURL, httpClient, rest.Config{
+		SettingsScope: rest.ScopeTransient,
+		// node_names parameter is not available before 7.8
+		VotingExclusions: rest.VotingExclusionsByPath,
+		SyncedFlush:      true,
+		IncludeDefaults:  true,
+		MasterRoles:      []string{"master"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package v8
+
+import (
+	"net/http"
+
+	"github.com/dtan4/esnctl/es/internal/rest"
+)
+
+// Client represents Elasticsearch 8.x API client
+// Transient cluster settings are deprecated in Elasticsearch 8.x, so this client updates persistent settings instead
+type Client struct {
+	*rest.Client
+}
+
+// NewClient creates new Client object
+func NewClient(clusterURL string, httpClient *http.Client) (*Client, error) {
+	client, err := rest.NewClient(clusterURL, httpClient, rest.Config{
+		SettingsScope:    rest.ScopePersistent,
+		VotingExclusions: rest.VotingExclusionsByQuery,
+		// Synced flush has been removed in 8.0 and normal flush does the same
+		SyncedFlush:     false,
+		IncludeDefaults: true,
+		MasterRoles:     []string{"master"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package v8
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+)
+
+const testClusterEndpoint = "http://example.com:9200"
+
+// Only the differences from other versions are tested here. Others are tested in es/internal/rest
+
+func TestAddVotingConfigExclusions(t *testing.T) {
+	defer gock.Off()
+
+	client, err := NewClient(testClusterEndpoint, &http.Client{})
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	gock.New(testClusterEndpoint).Post("/_cluster/voting_config_exclusions").MatchParam("node_names", "ip-10-0-1-21.ap-northeast-1.compute.internal,ip-10-0-1-22.ap-northeast-1.compute.internal").Reply(200)
+
+	nodeNames := []string{"ip-10-0-1-21.ap-northeast-1.compute.internal", "ip-10-0-1-22.ap-northeast-1.compute.internal"}
+
+	if err := client.AddVotingConfigExclusions(context.Background(), nodeNames); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestDisableReallocation(t *testing.T) {
+	defer gock.Off()
+
+	client, err := NewClient(testClusterEndpoint, &http.Client{})
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	gock.New(testClusterEndpoint).Put("/_cluster/settings").MatchType("json").BodyString(`{"persistent":{"cluster.routing.allocation.enable":"none"},"transient":{"cluster.routing.allocation.enable":null}}`).Reply(200)
+
+	if err := client.DisableReallocation(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
+
+func TestFlush(t *testing.T) {
+	defer gock.Off()
+
+	client, err := NewClient(testClusterEndpoint, &http.Client{})
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	gock.New(testClusterEndpoint).Post("/_flush").Reply(200).BodyString(`{"_shards":{"total":10,"successful":10,"failed":0}}`)
+
+	if err := client.Flush(context.Background()); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("all requests should be consumed")
+	}
+}
@@ -0,0 +1,214 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	stateDirEnv = "ESNCTL_STATE_DIR"
+
+	// maxIDAttempts is the number of suffixed run IDs tried when runs are created at the same second
+	maxIDAttempts = 100
+)
+
+// Run represents the progress of add / remove workflow
+type Run struct {
+	ID             string            `json:"id"`
+	Command        string            `json:"command"`
+	Values         map[string]string `json:"values"`
+	CompletedSteps []string          `json:"completed_steps"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+
+	dir string
+}
+
+// DefaultDir returns the directory to store state files
+// $ESNCTL_STATE_DIR is used if set, otherwise ~/.esnctl/runs
+func DefaultDir() (string, error) {
+	if dir := os.Getenv(stateDirEnv); dir != "" {
+		return dir, nil
+	}
+
+	home := os.Getenv("HOME")
+	if home == "" {
+		home = os.Getenv("USERPROFILE")
+	}
+
+	if home == "" {
+		return "", errors.New("failed to detect home directory")
+	}
+
+	return filepath.Join(home, ".esnctl", "runs"), nil
+}
+
+// New creates new Run object and saves it to the given directory
+// Run ID is <command>-<YYYYMMDDHHMMSS>, suffixed with -2, -3, ... if the ID is already used by another run
+func New(dir, command string, values map[string]string) (*Run, error) {
+	now := time.Now()
+
+	id, err := reserveID(dir, fmt.Sprintf("%s-%s", command, now.Format("20060102150405")))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create state file")
+	}
+
+	r := &Run{
+		ID:             id,
+		Command:        command,
+		Values:         values,
+		CompletedSteps: []string{},
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		dir:            dir,
+	}
+
+	if err := r.Save(); err != nil {
+		return nil, errors.Wrap(err, "failed to save state")
+	}
+
+	return r, nil
+}
+
+// reserveID creates an empty state file exclusively, so that concurrent runs never share one run ID
+func reserveID(dir, base string) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", errors.Wrap(err, "failed to create state directory")
+	}
+
+	for i := 1; i <= maxIDAttempts; i++ {
+		id := base
+		if i > 1 {
+			id = fmt.Sprintf("%s-%d", base, i)
+		}
+
+		f, err := os.OpenFile(filepath.Join(dir, id+".json"), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			if os.IsExist(err) {
+				continue
+			}
+
+			return "", errors.Wrap(err, "failed to create state file")
+		}
+
+		if err := f.Close(); err != nil {
+			return "", errors.Wrap(err, "failed to create state file")
+		}
+
+		return id, nil
+	}
+
+	return "", errors.Errorf("all run IDs from %q to %q are already used", base, fmt.Sprintf("%s-%d", base, maxIDAttempts))
+}
+
+// Load loads Run object from the state file in the given directory
+func Load(dir, id string) (*Run, error) {
+	body, err := ioutil.ReadFile(filepath.Join(dir, id+".json"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read state file of %q", id)
+	}
+
+	var r Run
+
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, errors.Wrapf(err, "invalid state file of %q", id)
+	}
+
+	if r.Values == nil {
+		r.Values = map[string]string{}
+	}
+
+	r.dir = dir
+
+	return &r, nil
+}
+
+// Path returns the path of state file
+func (r *Run) Path() string {
+	return filepath.Join(r.dir, r.ID+".json")
+}
+
+// Save writes Run object to the state file
+func (r *Run) Save() error {
+	if err := os.MkdirAll(r.dir, 0700); err != nil {
+		return errors.Wrap(err, "failed to create state directory")
+	}
+
+	r.UpdatedAt = time.Now()
+
+	body, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode state")
+	}
+
+	tmp := r.Path() + ".tmp"
+
+	if err := ioutil.WriteFile(tmp, body, 0600); err != nil {
+		return errors.Wrap(err, "failed to write state file")
+	}
+
+	if err := os.Rename(tmp, r.Path()); err != nil {
+		return errors.Wrap(err, "failed to write state file")
+	}
+
+	return nil
+}
+
+// Get returns the value stored with the given key
+func (r *Run) Get(key string) string {
+	return r.Values[key]
+}
+
+// Set stores the given value and saves the state
+func (r *Run) Set(key, value string) error {
+	if r.Values == nil {
+		r.Values = map[string]string{}
+	}
+
+	r.Values[key] = value
+
+	return r.Save()
+}
+
+// IsCompleted returns whether the given step has been completed
+func (r *Run) IsCompleted(step string) bool {
+	for _, s := range r.CompletedSteps {
+		if s == step {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Complete marks the given step as completed and saves the state
+func (r *Run) Complete(step string) error {
+	if r.IsCompleted(step) {
+		return nil
+	}
+
+	r.CompletedSteps = append(r.CompletedSteps, step)
+
+	return r.Save()
+}
+
+// Revert marks the given step as not completed and saves the state
+func (r *Run) Revert(step string) error {
+	steps := []string{}
+
+	for _, s := range r.CompletedSteps {
+		if s != step {
+			steps = append(steps, s)
+		}
+	}
+
+	r.CompletedSteps = steps
+
+	return r.Save()
+}
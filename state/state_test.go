@@ -0,0 +1,119 @@
+package state
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDefaultDir(t *testing.T) {
+	os.Setenv("ESNCTL_STATE_DIR", "/tmp/esnctl-state")
+	defer os.Unsetenv("ESNCTL_STATE_DIR")
+
+	expected := "/tmp/esnctl-state"
+
+	got, err := DefaultDir()
+	if err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("state directory does not match. expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestNewAndLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "esnctl-state")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	values := map[string]string{
+		"cluster_url": "http://example.com:9200",
+		"group":       "elasticsearch",
+	}
+
+	r, err := New(dir, "remove", values)
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, r.ID+".json")); err != nil {
+		t.Errorf("state file should be created: %s", err)
+	}
+
+	if err := r.Set("target_group_arn", "arn:aws:elasticloadbalancing:ap-northeast-1:012345678901:targetgroup/elasticsearch/0123abcd5678efab"); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if err := r.Complete("retrieve-target-group"); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	got, err := Load(dir, r.ID)
+	if err != nil {
+		t.Fatalf("error should not be raised: %s", err)
+	}
+
+	if got.Command != "remove" {
+		t.Errorf("command does not match. expected: %q, got: %q", "remove", got.Command)
+	}
+
+	if !reflect.DeepEqual(got.Values, r.Values) {
+		t.Errorf("values does not match. expected: %q, got: %q", r.Values, got.Values)
+	}
+
+	if !got.IsCompleted("retrieve-target-group") {
+		t.Errorf("step %q should be completed", "retrieve-target-group")
+	}
+
+	if got.IsCompleted("detach-instances") {
+		t.Errorf("step %q should not be completed", "detach-instances")
+	}
+
+	if err := got.Revert("retrieve-target-group"); err != nil {
+		t.Errorf("error should not be raised: %s", err)
+	}
+
+	if got.IsCompleted("retrieve-target-group") {
+		t.Errorf("step %q should not be completed after revert", "retrieve-target-group")
+	}
+}
+
+func TestNew_sameSecond(t *testing.T) {
+	dir, err := ioutil.TempDir("", "esnctl-state")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ids := map[string]bool{}
+
+	for i := 0; i < 3; i++ {
+		r, err := New(dir, "remove", map[string]string{})
+		if err != nil {
+			t.Fatalf("error should not be raised: %s", err)
+		}
+
+		if ids[r.ID] {
+			t.Errorf("run ID %q should not be reused", r.ID)
+		}
+
+		ids[r.ID] = true
+	}
+}
+
+func TestLoad_notFound(t *testing.T) {
+	dir, err := ioutil.TempDir("", "esnctl-state")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := Load(dir, "remove-20170101000000"); err == nil {
+		t.Errorf("error should be raised")
+	}
+}